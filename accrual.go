@@ -0,0 +1,102 @@
+package timefn
+
+import "slices"
+
+// StubPolicy decides where [AccrualSchedule] places the irregular period, or
+// stub, if the schedule does not divide evenly into regular periods.
+type StubPolicy int
+
+const (
+	// ShortFront places a short stub at the start of the schedule. Regular
+	// periods are generated backwards from the end date.
+	ShortFront StubPolicy = iota + 1
+
+	// LongFront merges the stub into the first regular period, which results in
+	// a long first period.
+	LongFront
+
+	// ShortBack places a short stub at the end of the schedule. Regular periods
+	// are generated forwards from the start date.
+	ShortBack
+
+	// LongBack merges the stub into the last regular period, which results in a
+	// long last period.
+	LongBack
+)
+
+var stubPolicyNames = [...]string{
+	ShortFront: "short front",
+	LongFront:  "long front",
+	ShortBack:  "short back",
+	LongBack:   "long back",
+}
+
+// String returns the name of the stub policy, e.g. "short front".
+func (s StubPolicy) String() string {
+	if s < ShortFront || s > LongBack {
+		return "<unknown stub policy>"
+	}
+	return stubPolicyNames[s]
+}
+
+// AccrualSchedule divides the dates from start up to end into consecutive
+// accrual periods of the given frequency, such as the coupon periods of a bond.
+// Regular period dates are computed from the start date, or from the end date
+// for front stubs, by adding multiples of freq as described by [AddTenor], so
+// that month-end dates do not drift. If the range does not divide evenly, the
+// stub policy decides where the irregular period is placed. Period dates are
+// not adjusted to business days; use [RollDate] for that.
+//
+// AccrualSchedule returns nil if end is not after start, if freq does not move
+// forward in time, or if the stub policy is unknown.
+func AccrualSchedule(start, end Date, freq CalendarDuration, stub StubPolicy) []DatePeriod {
+	if !end.After(start) || !start.addClamped(freq, 1).After(start) {
+		return nil
+	}
+
+	var boundaries []Date
+	switch stub {
+	case ShortBack, LongBack:
+		boundaries = append(boundaries, start)
+		n := 1
+		for ; ; n++ {
+			next := start.addClamped(freq.times(n), 1)
+			if !next.Before(end) {
+				break
+			}
+			boundaries = append(boundaries, next)
+		}
+
+		if stub == LongBack && len(boundaries) > 1 && start.addClamped(freq.times(n), 1) != end {
+			boundaries = boundaries[:len(boundaries)-1]
+		}
+		boundaries = append(boundaries, end)
+
+	case ShortFront, LongFront:
+		boundaries = append(boundaries, end)
+		n := 1
+		for ; ; n++ {
+			prev := end.addClamped(freq.times(n), -1)
+			if !prev.After(start) {
+				break
+			}
+			boundaries = append(boundaries, prev)
+		}
+
+		if stub == LongFront && len(boundaries) > 1 && end.addClamped(freq.times(n), -1) != start {
+			boundaries = boundaries[:len(boundaries)-1]
+		}
+		boundaries = append(boundaries, start)
+		slices.Reverse(boundaries)
+
+	default:
+		return nil
+	}
+
+	out := make([]DatePeriod, 0, len(boundaries)-1)
+	for i := 1; i < len(boundaries); i++ {
+		out = append(out, DatePeriod{Start: boundaries[i-1], End: boundaries[i]})
+	}
+
+	return out
+}
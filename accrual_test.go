@@ -0,0 +1,124 @@
+package timefn_test
+
+import (
+	"testing"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccrualSchedule(t *testing.T) {
+	quarterly := timefn.CalendarDuration{Months: 3}
+
+	tests := []struct {
+		name  string
+		start string
+		end   string
+		freq  timefn.CalendarDuration
+		stub  timefn.StubPolicy
+		want  []string
+	}{
+		{
+			name:  "regular",
+			start: "2023-01-15",
+			end:   "2023-07-15",
+			freq:  quarterly,
+			stub:  timefn.ShortFront,
+			want:  []string{"2023-01-15/2023-04-15", "2023-04-15/2023-07-15"},
+		},
+		{
+			name:  "short front",
+			start: "2023-02-01",
+			end:   "2023-07-15",
+			freq:  quarterly,
+			stub:  timefn.ShortFront,
+			want:  []string{"2023-02-01/2023-04-15", "2023-04-15/2023-07-15"},
+		},
+		{
+			name:  "long front",
+			start: "2023-02-01",
+			end:   "2023-07-15",
+			freq:  quarterly,
+			stub:  timefn.LongFront,
+			want:  []string{"2023-02-01/2023-07-15"},
+		},
+		{
+			name:  "short back",
+			start: "2023-01-15",
+			end:   "2023-09-01",
+			freq:  quarterly,
+			stub:  timefn.ShortBack,
+			want:  []string{"2023-01-15/2023-04-15", "2023-04-15/2023-07-15", "2023-07-15/2023-09-01"},
+		},
+		{
+			name:  "long back",
+			start: "2023-01-15",
+			end:   "2023-09-01",
+			freq:  quarterly,
+			stub:  timefn.LongBack,
+			want:  []string{"2023-01-15/2023-04-15", "2023-04-15/2023-09-01"},
+		},
+		{
+			name:  "long back regular",
+			start: "2023-01-15",
+			end:   "2023-07-15",
+			freq:  quarterly,
+			stub:  timefn.LongBack,
+			want:  []string{"2023-01-15/2023-04-15", "2023-04-15/2023-07-15"},
+		},
+		{
+			name:  "stub only",
+			start: "2023-01-15",
+			end:   "2023-02-01",
+			freq:  quarterly,
+			stub:  timefn.LongBack,
+			want:  []string{"2023-01-15/2023-02-01"},
+		},
+		{
+			name:  "month end",
+			start: "2023-01-31",
+			end:   "2023-05-31",
+			freq:  timefn.CalendarDuration{Months: 1},
+			stub:  timefn.ShortBack,
+			want:  []string{"2023-01-31/2023-02-28", "2023-02-28/2023-03-31", "2023-03-31/2023-04-30", "2023-04-30/2023-05-31"},
+		},
+		{
+			name:  "invalid range",
+			start: "2023-07-15",
+			end:   "2023-01-15",
+			freq:  quarterly,
+			stub:  timefn.ShortBack,
+		},
+		{
+			name:  "zero frequency",
+			start: "2023-01-15",
+			end:   "2023-07-15",
+			stub:  timefn.ShortBack,
+		},
+		{
+			name:  "unknown stub policy",
+			start: "2023-01-15",
+			end:   "2023-07-15",
+			freq:  quarterly,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, err := timefn.ParseDate(tt.start)
+			if err != nil {
+				t.Fatalf("parse start: %v", err)
+			}
+			end, err := timefn.ParseDate(tt.end)
+			if err != nil {
+				t.Fatalf("parse end: %v", err)
+			}
+
+			var got []string
+			for _, p := range timefn.AccrualSchedule(start, end, tt.freq, tt.stub) {
+				got = append(got, p.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package timefn
+
+import "time"
+
+// Tick is a tick on a time axis, as returned by [AxisTicks].
+type Tick struct {
+	// Time is the instant of the tick.
+	Time time.Time
+
+	// Layout is a suggested layout for the label of the tick. It depends on
+	// the calendar boundary the tick falls on, e.g. "Jan" for the start of a
+	// month between daily ticks, or "2" for the other daily ticks.
+	Layout string
+}
+
+// Label returns the label of the tick, which is its time formatted using the
+// suggested layout.
+func (t Tick) Label() string {
+	return t.Time.Format(t.Layout)
+}
+
+// axisStep is a step between the ticks of a time axis.
+type axisStep struct {
+	unit   Unit
+	n      int
+	layout string
+}
+
+// axisSteps are the supported steps between ticks, from the finest to the
+// coarsest. Steps of more years are derived from the last one.
+var axisSteps = [...]axisStep{
+	{UnitSecond, 1, "15:04:05"},
+	{UnitSecond, 5, "15:04:05"},
+	{UnitSecond, 10, "15:04:05"},
+	{UnitSecond, 15, "15:04:05"},
+	{UnitSecond, 30, "15:04:05"},
+	{UnitMinute, 1, "15:04"},
+	{UnitMinute, 5, "15:04"},
+	{UnitMinute, 10, "15:04"},
+	{UnitMinute, 15, "15:04"},
+	{UnitMinute, 30, "15:04"},
+	{UnitHour, 1, "15:04"},
+	{UnitHour, 3, "15:04"},
+	{UnitHour, 6, "15:04"},
+	{UnitHour, 12, "15:04"},
+	{UnitDay, 1, "2"},
+	{UnitWeek, 1, "2"},
+	{UnitMonth, 1, "Jan"},
+	{UnitMonth, 3, "Jan"},
+	{UnitMonth, 6, "Jan"},
+	{UnitYear, 1, "2006"},
+	{UnitYear, 2, "2006"},
+	{UnitYear, 5, "2006"},
+	{UnitYear, 10, "2006"},
+}
+
+// AxisTicks returns the ticks for a time axis that spans the period p in the
+// location loc. It chooses the finest of a fixed set of steps, such as 15
+// minutes, 6 hours, 1 day or 3 months, that results in at most maxTicks
+// ticks. Ticks are aligned to the calendar in loc: hourly ticks fall on full
+// hours, daily ticks on midnight, weekly ticks on Mondays and monthly ticks on
+// the first of the month. Ticks within the period, including its boundaries,
+// are returned.
+//
+// Each tick carries a suggested layout for its label. Ticks that fall on a
+// boundary of a larger unit than the step are labeled by that boundary, so
+// with hourly ticks, midnight is labeled with the date ("Jan 2"), and with
+// daily ticks, the first of a month is labeled with the month name ("Jan") and
+// January 1 with the year ("2006").
+//
+// AxisTicks returns nil if p is not valid, loc is nil or maxTicks is not
+// positive.
+func AxisTicks(p Period, maxTicks int, loc *time.Location) []Tick {
+	if p.Validate() != nil || loc == nil || maxTicks <= 0 {
+		return nil
+	}
+
+	start, end := p.Start.In(loc), p.End.In(loc)
+
+	for i := 0; ; i++ {
+		step := axisSteps[len(axisSteps)-1]
+		if i < len(axisSteps) {
+			step = axisSteps[i]
+		} else {
+			for j := len(axisSteps); j <= i; j++ {
+				step.n *= 10
+			}
+		}
+
+		if ticks, ok := step.ticks(start, end, maxTicks); ok {
+			return ticks
+		}
+	}
+}
+
+// ticks returns the ticks of the step between start and end, or false if
+// there are more than maxTicks ticks.
+func (s axisStep) ticks(start, end time.Time, maxTicks int) ([]Tick, bool) {
+	t := s.floor(start)
+	if t.Before(start) {
+		t = s.next(t)
+	}
+
+	var out []Tick
+	for ; !t.After(end); t = s.next(t) {
+		if len(out) == maxTicks {
+			return nil, false
+		}
+		out = append(out, Tick{Time: t, Layout: s.layoutOf(t)})
+	}
+
+	return out, true
+}
+
+// floor returns the last tick of the step at or before t.
+func (s axisStep) floor(t time.Time) time.Time {
+	y, m, d := t.Date()
+	h, mi, sec := t.Clock()
+	frac := time.Duration(t.Nanosecond())
+
+	switch s.unit {
+	case UnitSecond:
+		return t.Add(-time.Duration(sec%s.n)*time.Second - frac)
+	case UnitMinute:
+		return t.Add(-time.Duration(mi%s.n)*time.Minute - time.Duration(sec)*time.Second - frac)
+	case UnitHour:
+		return time.Date(y, m, d, h-h%s.n, 0, 0, 0, t.Location())
+	case UnitDay:
+		return firstInstantOf(y, m, d, t.Location())
+	case UnitWeek:
+		return UnitWeek.Start(t)
+	case UnitMonth:
+		return firstInstantOf(y, m-time.Month((int(m)-1)%s.n), 1, t.Location())
+	default:
+		return firstInstantOf(y-floorMod(y, s.n), time.January, 1, t.Location())
+	}
+}
+
+// next returns the tick of the step that follows the tick t.
+func (s axisStep) next(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	switch s.unit {
+	case UnitSecond, UnitMinute:
+		return t.Add(time.Duration(s.n) * s.unit.approx())
+	case UnitHour:
+		// Stepping on the wall clock keeps ticks aligned across DST
+		// transitions; if that does not move forward because an hour is
+		// repeated, skip to the following tick.
+		step := time.Duration(s.n) * time.Hour
+		if next := s.floor(t.Add(step)); next.After(t) {
+			return next
+		}
+		return s.floor(t.Add(2 * step))
+	case UnitDay:
+		return firstInstantOf(y, m, d+s.n, t.Location())
+	case UnitWeek:
+		return firstInstantOf(y, m, d+7*s.n, t.Location())
+	case UnitMonth:
+		return firstInstantOf(y, m+time.Month(s.n), 1, t.Location())
+	default:
+		return firstInstantOf(y+s.n, time.January, 1, t.Location())
+	}
+}
+
+// layoutOf returns the suggested label layout for the tick t.
+func (s axisStep) layoutOf(t time.Time) string {
+	y, m, d := t.Date()
+	if s.unit < UnitDay && !t.Equal(firstInstantOf(y, m, d, t.Location())) {
+		return s.layout
+	}
+
+	switch {
+	case s.unit < UnitYear && m == time.January && d == 1:
+		return "2006"
+	case s.unit < UnitMonth && d == 1:
+		return "Jan"
+	case s.unit < UnitDay:
+		return "Jan 2"
+	default:
+		return s.layout
+	}
+}
+
+// floorMod returns a modulo n, which is never negative for positive n.
+func floorMod(a, n int) int {
+	return ((a % n) + n) % n
+}
@@ -0,0 +1,135 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestAxisTicks(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		p          timefn.Period
+		maxTicks   int
+		loc        *time.Location
+		wantLabels []string
+	}{
+		{
+			name: "hours across midnight",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 1, 17, 20, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 2, 10, 0, 0, 0, time.UTC),
+			},
+			maxTicks:   6,
+			loc:        time.UTC,
+			wantLabels: []string{"18:00", "21:00", "Mar 2", "03:00", "06:00", "09:00"},
+		},
+		{
+			name: "days across month start",
+			p: timefn.Period{
+				Start: time.Date(2023, time.January, 29, 0, 0, 0, 0, berlin),
+				End:   time.Date(2023, time.February, 3, 0, 0, 0, 0, berlin),
+			},
+			maxTicks:   10,
+			loc:        berlin,
+			wantLabels: []string{"29", "30", "31", "Feb", "2", "3"},
+		},
+		{
+			name: "ticks in the given location",
+			p: timefn.Period{
+				Start: time.Date(2023, time.January, 28, 23, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.January, 30, 23, 0, 0, 0, time.UTC),
+			},
+			maxTicks:   3,
+			loc:        berlin,
+			wantLabels: []string{"29", "30", "31"},
+		},
+		{
+			name: "months across year start",
+			p: timefn.Period{
+				Start: time.Date(2022, time.October, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC),
+			},
+			maxTicks:   6,
+			loc:        time.UTC,
+			wantLabels: []string{"Nov", "Dec", "2023", "Feb", "Mar"},
+		},
+		{
+			name: "quarters",
+			p: timefn.Period{
+				Start: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC),
+			},
+			maxTicks:   10,
+			loc:        time.UTC,
+			wantLabels: []string{"2022", "Apr", "Jul", "Oct", "2023", "Apr", "Jul", "Oct"},
+		},
+		{
+			name: "decades",
+			p: timefn.Period{
+				Start: time.Date(1975, time.January, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+			maxTicks:   5,
+			loc:        time.UTC,
+			wantLabels: []string{"1980", "1990", "2000", "2010", "2020"},
+		},
+		{
+			name: "hours across dst",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 26, 0, 0, 0, 0, berlin),
+				End:   time.Date(2023, time.March, 26, 4, 0, 0, 0, berlin),
+			},
+			maxTicks:   5,
+			loc:        berlin,
+			wantLabels: []string{"Mar 26", "01:00", "03:00", "04:00"},
+		},
+		{
+			name: "invalid period",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 2, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+			},
+			maxTicks: 5,
+			loc:      time.UTC,
+		},
+		{
+			name: "no ticks",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 2, 0, 0, 0, 0, time.UTC),
+			},
+			loc: time.UTC,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticks := timefn.AxisTicks(tt.p, tt.maxTicks, tt.loc)
+
+			labels := make([]string, 0, len(ticks))
+			for _, tick := range ticks {
+				if tick.Time.Location() != tt.loc {
+					t.Errorf("tick %v is not in %v", tick.Time, tt.loc)
+				}
+				labels = append(labels, tick.Label())
+			}
+
+			if len(labels) != len(tt.wantLabels) {
+				t.Fatalf("AxisTicks() labels = %v, want %v", labels, tt.wantLabels)
+			}
+			for i := range labels {
+				if labels[i] != tt.wantLabels[i] {
+					t.Errorf("AxisTicks() labels = %v, want %v", labels, tt.wantLabels)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package timefn
+
+import "time"
+
+// MakePeriods returns an empty slice of periods with capacity for n periods.
+// It is meant as a reusable buffer for the functions that write their results
+// into a caller-provided slice, such as [MergeInto] and [CutInto], which then
+// do not allocate as long as the results fit into the buffer.
+func MakePeriods(n int) []Period {
+	return make([]Period, 0, n)
+}
+
+// MergeInto merges the periods of src like [Merge] and writes the result into
+// dst, reusing its backing array. The previous contents of dst are
+// overwritten, and the returned slice only allocates if the capacity of dst is
+// smaller than len(src). MergeInPlace has no effect. The periods of src are
+// not modified, but dst and src must not share a backing array; use [Merge]
+// with [MergeInPlace] to merge a slice in place.
+func MergeInto(dst, src []Period, opts ...MergeOption) []Period {
+	var step time.Duration
+	if len(opts) > 0 {
+		// The config escapes to the heap, so it is only created if needed.
+		var cfg mergeConfig
+		for _, opt := range opts {
+			opt(&cfg)
+		}
+		step = cfg.step
+	}
+
+	dst = dst[:0]
+	for _, p := range src {
+		dst = append(dst, p.closed())
+	}
+
+	return reopenAll(mergeClosed(dst, step))
+}
+
+// CutInto cuts the periods in cut out of p like [Period.Cut] and writes the
+// remaining fragments into dst, reusing its backing array. The previous
+// contents of dst are overwritten. If the periods in cut are bounded and
+// already sorted without overlaps, as returned by [Merge] or [MergeInto],
+// CutInto does not allocate as long as the fragments fit into dst; otherwise
+// it merges a copy of cut first. The periods in cut are not modified.
+func CutInto(dst []Period, p Period, cut []Period) []Period {
+	dst = dst[:0]
+	if p.IsZero() {
+		return append(dst, p)
+	}
+
+	if !isMergedClosed(cut) {
+		cut = mergedClosed(cut)
+	}
+
+	dst, _ = cutSweep(dst, p.closed(), cut, 0)
+
+	return reopenAll(dst)
+}
+
+// isMergedClosed returns whether the periods are bounded, not inverted and
+// sorted without overlaps, so that they can be passed to [cutSweep] as they
+// are.
+func isMergedClosed(periods []Period) bool {
+	for _, p := range periods {
+		if p.IsZero() || p.OpenStart() || p.OpenEnd() || p.End.Before(p.Start) {
+			return false
+		}
+	}
+	return IsNonOverlapping(periods)
+}
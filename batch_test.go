@@ -0,0 +1,87 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestMergeInto(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: at(start), End: at(end)} }
+
+	src := []timefn.Period{p(4, 6), p(0, 2), p(1, 3), {Start: at(8)}}
+	buf := timefn.MakePeriods(len(src))
+
+	got := timefn.MergeInto(buf, src)
+	want := timefn.Merge(src)
+
+	if len(got) != len(want) {
+		t.Fatalf("MergeInto() = %v; want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("MergeInto() = %v; want %v", got, want)
+			break
+		}
+	}
+
+	if &got[0] != &buf[:1][0] {
+		t.Errorf("MergeInto() should reuse the backing array of dst")
+	}
+
+	if src[0] != p(4, 6) {
+		t.Errorf("MergeInto() modified src: %v", src)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { buf = timefn.MergeInto(buf, src) }); allocs > 0 {
+		t.Errorf("MergeInto() allocates %v times; want 0", allocs)
+	}
+
+	got = timefn.MergeInto(buf, []timefn.Period{p(0, 1), p(1, 2)}, timefn.MergeWithStep(time.Nanosecond))
+	if len(got) != 2 {
+		t.Errorf("MergeInto() with step = %v; want 2 periods", got)
+	}
+}
+
+func TestCutInto(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: at(start), End: at(end)} }
+
+	base := p(0, 10)
+
+	tests := []struct {
+		name string
+		cut  []timefn.Period
+	}{
+		{name: "merged", cut: []timefn.Period{p(1, 2), p(4, 5), p(8, 12)}},
+		{name: "unsorted", cut: []timefn.Period{p(4, 5), p(1, 2)}},
+		{name: "overlapping", cut: []timefn.Period{p(1, 3), p(2, 5)}},
+		{name: "open", cut: []timefn.Period{{Start: at(7)}}},
+		{name: "none"},
+	}
+
+	buf := timefn.MakePeriods(8)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timefn.CutInto(buf, base, tt.cut)
+			want := base.Cut(tt.cut...)
+
+			if len(got) != len(want) {
+				t.Fatalf("CutInto() = %v; want %v", got, want)
+			}
+			for i := range got {
+				if got[i] != want[i] {
+					t.Errorf("CutInto() = %v; want %v", got, want)
+					break
+				}
+			}
+		})
+	}
+
+	cut := []timefn.Period{p(1, 2), p(4, 5)}
+	if allocs := testing.AllocsPerRun(100, func() { buf = timefn.CutInto(buf, base, cut) }); allocs > 0 {
+		t.Errorf("CutInto() allocates %v times; want 0", allocs)
+	}
+}
@@ -0,0 +1,220 @@
+package timefn
+
+import "time"
+
+// maxBusinessDaySearch is the number of days that business time arithmetic
+// searches for open hours before giving up.
+const maxBusinessDaySearch = 5 * 366
+
+// DailyWindow is a window of wall-clock time within a day, given as offsets
+// from midnight. For example, the window from 09:00 to 17:30 is
+//
+//	DailyWindow{Start: 9 * time.Hour, End: 17*time.Hour + 30*time.Minute}
+//
+// The offsets describe wall-clock times, not elapsed durations, so 09:00 stays
+// 09:00 on days with a daylight saving time transition. End may exceed 24
+// hours for windows that extend into the next day.
+type DailyWindow struct {
+	Start time.Duration `json:"start"`
+	End   time.Duration `json:"end"`
+}
+
+// WeeklySchedule defines recurring open hours for each day of the week,
+// indexed by [time.Weekday]. Each weekday may have any number of
+// non-overlapping [DailyWindow]s:
+//
+//	timefn.WeeklySchedule{
+//		time.Monday: {{Start: 9 * time.Hour, End: 17 * time.Hour}},
+//		time.Friday: {{Start: 9 * time.Hour, End: 12 * time.Hour}},
+//	}
+type WeeklySchedule [7][]DailyWindow
+
+// BusinessDays reports whether a given day is a business day. It is used to
+// close days such as public holidays that would otherwise be open according
+// to a [WeeklySchedule].
+type BusinessDays interface {
+	// IsBusinessDay reports whether the day of the given time is a business
+	// day.
+	IsBusinessDay(t time.Time) bool
+}
+
+// IsZero reports whether the schedule has no open hours at all.
+func (s WeeklySchedule) IsZero() bool {
+	for _, windows := range s {
+		for _, w := range windows {
+			if w.End > w.Start {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// windowsOn returns the open windows of the given date in loc, sorted by their
+// start. date must be midnight in UTC and represents the wall date.
+func (s WeeklySchedule) windowsOn(date time.Time, loc *time.Location) []Period {
+	windows := s[date.Weekday()]
+	if len(windows) == 0 {
+		return nil
+	}
+
+	out := make([]Period, 0, len(windows))
+	for _, w := range windows {
+		start, _ := resolveWallClock(date.Add(w.Start), loc, wallClockConfig{})
+		end, _ := resolveWallClock(date.Add(w.End), loc, wallClockConfig{})
+		if start.Before(end) {
+			out = append(out, Period{Start: start, End: end})
+		}
+	}
+	SortPeriods(out)
+
+	return out
+}
+
+// AddBusiness shifts both boundaries of the period forward by d of business
+// time. Business time only passes during the open hours of the schedule, on
+// days that cal reports as business days; if cal is nil, every day is a
+// business day. A boundary that lies outside of the open hours starts to move
+// at the next opening. The schedule is evaluated in the location of each
+// boundary.
+//
+// AddBusiness returns the period unchanged if d is not positive, or if the
+// schedule has no open hours within the next five years.
+func AddBusiness(p Period, d time.Duration, schedule WeeklySchedule, cal BusinessDays) Period {
+	if d <= 0 || schedule.IsZero() {
+		return p
+	}
+
+	start, ok := addBusinessTime(p.Start, d, schedule, cal)
+	if !ok {
+		return p
+	}
+
+	end, ok := addBusinessTime(p.End, d, schedule, cal)
+	if !ok {
+		return p
+	}
+
+	return Period{Start: start, End: end}
+}
+
+func addBusinessTime(t time.Time, d time.Duration, schedule WeeklySchedule, cal BusinessDays) (time.Time, bool) {
+	if t.IsZero() {
+		return t, true
+	}
+
+	loc := t.Location()
+	y, m, day := t.Date()
+
+	// Start one day early to catch windows that extend past midnight.
+	for i := -1; i < maxBusinessDaySearch; i++ {
+		if cal != nil && !cal.IsBusinessDay(time.Date(y, m, day+i, 0, 0, 0, 0, loc)) {
+			continue
+		}
+
+		for _, w := range schedule.windowsOn(time.Date(y, m, day+i, 0, 0, 0, 0, time.UTC), loc) {
+			if !w.End.After(t) {
+				continue
+			}
+
+			start := w.Start
+			if t.After(start) {
+				start = t
+			}
+
+			available := w.End.Sub(start)
+			if d <= available {
+				return start.Add(d), true
+			}
+			d -= available
+
+			// Windows of adjacent days may overlap, e.g. if a window
+			// extends past midnight, so business time must not be
+			// counted again before the end of this window.
+			t = w.End
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// BusinessHours are the recurring open hours of a business, such as Monday to
+// Friday from 09:00 to 17:30, in a specific location. Days that are not
+// business days according to Days are closed; if Days is nil, every day is a
+// business day.
+type BusinessHours struct {
+	Schedule WeeklySchedule
+	Location *time.Location
+	Days     BusinessDays
+}
+
+// Periods returns the open windows of the business hours that overlap with the
+// given period, clipped to it and sorted by their start. Windows that touch
+// or overlap each other, e.g. across midnight, are merged. The windows are
+// returned in Location; if Location is nil, the location of within.Start is
+// used. Periods returns nil if within is not valid.
+func (h BusinessHours) Periods(within Period) []Period {
+	if within.Validate() != nil || h.Schedule.IsZero() {
+		return nil
+	}
+
+	loc := h.Location
+	if loc == nil {
+		loc = within.Start.Location()
+	}
+
+	var windows []Period
+	y, m, d := within.Start.In(loc).Date()
+
+	// Start one day early to catch windows that extend past midnight.
+	for i := -1; ; i++ {
+		local := time.Date(y, m, d+i, 0, 0, 0, 0, loc)
+		if !local.Before(within.End) {
+			break
+		}
+
+		if h.Days != nil && !h.Days.IsBusinessDay(local) {
+			continue
+		}
+
+		windows = append(windows, h.Schedule.windowsOn(time.Date(y, m, d+i, 0, 0, 0, 0, time.UTC), loc)...)
+	}
+
+	out := Intersect(windows, []Period{within})
+	for i, p := range out {
+		out[i] = p.In(loc)
+	}
+
+	return out
+}
+
+// CommonOfficeHours returns the periods within the given period during which
+// all offices are open at the same time, sorted by their start. Each office is
+// identified by a key of schedules, and its schedule is evaluated in the
+// location of the same key in zones; offices without a location use UTC. The
+// periods are returned in the location of within.Start. CommonOfficeHours
+// returns nil if there are no offices or if within is not valid.
+func CommonOfficeHours(schedules map[string]WeeklySchedule, zones map[string]*time.Location, within Period) []Period {
+	if len(schedules) == 0 || within.Validate() != nil {
+		return nil
+	}
+
+	common := []Period{within}
+	for office, schedule := range schedules {
+		loc := zones[office]
+		if loc == nil {
+			loc = time.UTC
+		}
+
+		common = Intersect(common, BusinessHours{Schedule: schedule, Location: loc}.Periods(within))
+		if len(common) == 0 {
+			return nil
+		}
+	}
+
+	for i, p := range common {
+		common[i] = p.In(within.Start.Location())
+	}
+
+	return common
+}
@@ -0,0 +1,228 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+var officeHours = timefn.WeeklySchedule{
+	time.Monday:    {{Start: 9 * time.Hour, End: 12 * time.Hour}, {Start: 13 * time.Hour, End: 17 * time.Hour}},
+	time.Tuesday:   {{Start: 9 * time.Hour, End: 12 * time.Hour}, {Start: 13 * time.Hour, End: 17 * time.Hour}},
+	time.Wednesday: {{Start: 9 * time.Hour, End: 12 * time.Hour}, {Start: 13 * time.Hour, End: 17 * time.Hour}},
+	time.Thursday:  {{Start: 9 * time.Hour, End: 12 * time.Hour}, {Start: 13 * time.Hour, End: 17 * time.Hour}},
+	time.Friday:    {{Start: 9 * time.Hour, End: 12 * time.Hour}, {Start: 13 * time.Hour, End: 17 * time.Hour}},
+}
+
+type closedDays []time.Time
+
+func (days closedDays) IsBusinessDay(t time.Time) bool {
+	for _, d := range days {
+		if d.Year() == t.Year() && d.YearDay() == t.YearDay() {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAddBusiness(t *testing.T) {
+	// Monday
+	mar6 := time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC)
+	at := func(day, hour, min int) time.Time {
+		return mar6.AddDate(0, 0, day).Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+	}
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		d      time.Duration
+		cal    timefn.BusinessDays
+		want   timefn.Period
+	}{
+		{
+			name:   "within the same window",
+			period: timefn.Period{Start: at(0, 9, 0), End: at(0, 10, 0)},
+			d:      time.Hour,
+			want:   timefn.Period{Start: at(0, 10, 0), End: at(0, 11, 0)},
+		},
+		{
+			name:   "across lunch break",
+			period: timefn.Period{Start: at(0, 10, 0), End: at(0, 11, 0)},
+			d:      4 * time.Hour,
+			want:   timefn.Period{Start: at(0, 15, 0), End: at(0, 16, 0)},
+		},
+		{
+			name:   "into the next day",
+			period: timefn.Period{Start: at(0, 15, 0), End: at(0, 16, 0)},
+			d:      4 * time.Hour,
+			want:   timefn.Period{Start: at(1, 11, 0), End: at(1, 12, 0)},
+		},
+		{
+			name:   "from closed hours",
+			period: timefn.Period{Start: at(0, 6, 0), End: at(0, 20, 0)},
+			d:      30 * time.Minute,
+			want:   timefn.Period{Start: at(0, 9, 30), End: at(1, 9, 30)},
+		},
+		{
+			name:   "over the weekend",
+			period: timefn.Period{Start: at(4, 16, 0), End: at(4, 16, 30)},
+			d:      2 * time.Hour,
+			want:   timefn.Period{Start: at(7, 10, 0), End: at(7, 10, 30)},
+		},
+		{
+			name:   "skipping a holiday",
+			period: timefn.Period{Start: at(0, 16, 0), End: at(0, 17, 0)},
+			d:      2 * time.Hour,
+			cal:    closedDays{at(1, 0, 0)},
+			want:   timefn.Period{Start: at(2, 10, 0), End: at(2, 11, 0)},
+		},
+		{
+			name:   "ending at closing time",
+			period: timefn.Period{Start: at(0, 16, 0), End: at(0, 16, 30)},
+			d:      time.Hour,
+			want:   timefn.Period{Start: at(0, 17, 0), End: at(1, 9, 30)},
+		},
+		{
+			name:   "zero duration",
+			period: timefn.Period{Start: at(0, 6, 0), End: at(0, 7, 0)},
+			d:      0,
+			want:   timefn.Period{Start: at(0, 6, 0), End: at(0, 7, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timefn.AddBusiness(tt.period, tt.d, officeHours, tt.cal)
+			if !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) {
+				t.Errorf("AddBusiness(%s, %s) = %s, want %s", tt.period, tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddBusiness_overlappingDays(t *testing.T) {
+	// Monday
+	mar6 := time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC)
+	schedule := timefn.WeeklySchedule{
+		time.Monday:  {{Start: 20 * time.Hour, End: 26 * time.Hour}},
+		time.Tuesday: {{Start: 0, End: 4 * time.Hour}},
+	}
+
+	p := timefn.Period{Start: mar6.Add(20 * time.Hour), End: mar6.Add(21 * time.Hour)}
+	want := timefn.Period{Start: mar6.Add(27 * time.Hour), End: mar6.Add(28 * time.Hour)}
+
+	if got := timefn.AddBusiness(p, 7*time.Hour, schedule, nil); got != want {
+		t.Errorf("AddBusiness() = %s; want %s", got, want)
+	}
+}
+
+func TestAddBusiness_emptySchedule(t *testing.T) {
+	p := timefn.Period{Start: time.Now(), End: time.Now().Add(time.Hour)}
+	if got := timefn.AddBusiness(p, time.Hour, timefn.WeeklySchedule{}, nil); got != p {
+		t.Errorf("AddBusiness() with an empty schedule should return the period unchanged; got %s", got)
+	}
+}
+
+func TestBusinessHours_Periods(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	at := func(d, h, min int) time.Time {
+		return time.Date(2023, time.March, d, h, min, 0, 0, berlin)
+	}
+
+	hours := timefn.BusinessHours{
+		Schedule: timefn.WeeklySchedule{
+			time.Monday:   {{Start: 9 * time.Hour, End: 17*time.Hour + 30*time.Minute}},
+			time.Tuesday:  {{Start: 9 * time.Hour, End: 17*time.Hour + 30*time.Minute}},
+			time.Friday:   {{Start: 9 * time.Hour, End: 17*time.Hour + 30*time.Minute}},
+			time.Saturday: {{Start: 22 * time.Hour, End: 26 * time.Hour}},
+		},
+		Location: berlin,
+		Days:     closedDays{at(28, 0, 0)},
+	}
+
+	// Friday 12:00 to Wednesday 00:00, across the DST transition on Sunday.
+	within := timefn.Period{Start: at(24, 12, 0).UTC(), End: at(29, 0, 0).UTC()}
+
+	want := []timefn.Period{
+		{Start: at(24, 12, 0), End: at(24, 17, 30)},
+		{Start: at(25, 22, 0), End: at(26, 2, 0)},
+		{Start: at(27, 9, 0), End: at(27, 17, 30)},
+	}
+
+	got := hours.Periods(within)
+	if len(got) != len(want) {
+		t.Fatalf("Periods() = %v, want %v", got, want)
+	}
+
+	for i := range got {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("Periods()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCommonOfficeHours(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	nineToFive := []timefn.DailyWindow{{Start: 9 * time.Hour, End: 17 * time.Hour}}
+	weekdays := timefn.WeeklySchedule{
+		time.Monday:    nineToFive,
+		time.Tuesday:   nineToFive,
+		time.Wednesday: nineToFive,
+		time.Thursday:  nineToFive,
+		time.Friday:    nineToFive,
+	}
+
+	schedules := map[string]timefn.WeeklySchedule{"nyc": weekdays, "ber": weekdays}
+	zones := map[string]*time.Location{"nyc": newYork, "ber": berlin}
+
+	// Monday, March 6 to Sunday, March 12, 2023. New York switches to DST on
+	// March 12, Berlin on March 26.
+	within := timefn.Period{
+		Start: time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.March, 13, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := timefn.CommonOfficeHours(schedules, zones, within)
+	if len(got) != 5 {
+		t.Fatalf("CommonOfficeHours() returned %d periods; want 5: %v", len(got), got)
+	}
+
+	for i, p := range got {
+		day := 6 + i
+		want := timefn.Period{
+			Start: time.Date(2023, time.March, day, 14, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, time.March, day, 16, 0, 0, 0, time.UTC),
+		}
+		if !p.EqualWithin(want, 0) {
+			t.Errorf("period %d = %v; want %v", i, p, want)
+		}
+	}
+
+	// Tokyo and New York office hours never overlap.
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	schedules["tyo"] = weekdays
+	zones["tyo"] = tokyo
+	if got := timefn.CommonOfficeHours(schedules, zones, within); got != nil {
+		t.Errorf("CommonOfficeHours() = %v; want nil", got)
+	}
+
+	if got := timefn.CommonOfficeHours(nil, nil, within); got != nil {
+		t.Errorf("CommonOfficeHours() without offices = %v; want nil", got)
+	}
+}
@@ -0,0 +1,215 @@
+package timefn
+
+import (
+	"sync"
+	"time"
+)
+
+// BusinessCalendar defines business days as all days that are neither weekend
+// days nor holidays. It implements [BusinessDays]. Use [NewBusinessCalendar]
+// to create one; the zero value has no weekend days and no holidays.
+//
+// Days are compared by their wall date, as seen in the location of the given
+// time, so a holiday on 2023-12-25 closes December 25 in every location.
+type BusinessCalendar struct {
+	weekend   [7]bool
+	holidays  map[Date]struct{}
+	providers []HolidayProvider
+
+	mux   sync.Mutex
+	years map[int]map[Date]struct{}
+}
+
+// BusinessCalendarOption is an option for [NewBusinessCalendar].
+type BusinessCalendarOption func(*BusinessCalendar)
+
+// WithWeekend returns a [BusinessCalendarOption] that sets the weekend days of
+// the calendar, replacing the default of Saturday and Sunday. Calling it
+// without any days results in a calendar without weekend days.
+func WithWeekend(days ...time.Weekday) BusinessCalendarOption {
+	return func(c *BusinessCalendar) {
+		c.weekend = [7]bool{}
+		for _, d := range days {
+			c.weekend[d%7] = true
+		}
+	}
+}
+
+// WithHolidays returns a [BusinessCalendarOption] that adds the dates of the
+// given times as holidays to the calendar.
+func WithHolidays(dates ...time.Time) BusinessCalendarOption {
+	return func(c *BusinessCalendar) {
+		for _, d := range dates {
+			c.holidays[DateOf(d)] = struct{}{}
+		}
+	}
+}
+
+// WithHolidayProvider returns a [BusinessCalendarOption] that adds the
+// holidays of the given provider, such as [USFederalHolidays], to the
+// calendar. The holidays of each year are requested from the provider once and
+// then cached.
+func WithHolidayProvider(p HolidayProvider) BusinessCalendarOption {
+	return func(c *BusinessCalendar) {
+		c.providers = append(c.providers, p)
+	}
+}
+
+// NewBusinessCalendar returns a [BusinessCalendar] with a weekend of Saturday
+// and Sunday and no holidays, configured by the given options.
+func NewBusinessCalendar(opts ...BusinessCalendarOption) *BusinessCalendar {
+	c := &BusinessCalendar{holidays: make(map[Date]struct{})}
+	c.weekend[time.Saturday] = true
+	c.weekend[time.Sunday] = true
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// IsWeekend reports whether the day of t is a weekend day of the calendar.
+func (c *BusinessCalendar) IsWeekend(t time.Time) bool {
+	return c.weekend[t.Weekday()]
+}
+
+// IsHoliday reports whether the day of t is a holiday of the calendar.
+func (c *BusinessCalendar) IsHoliday(t time.Time) bool {
+	d := DateOf(t)
+	if _, ok := c.holidays[d]; ok {
+		return true
+	}
+
+	if len(c.providers) == 0 {
+		return false
+	}
+
+	// Observed holidays may be provided for the adjacent year.
+	for year := d.Year - 1; year <= d.Year+1; year++ {
+		if _, ok := c.providedHolidays(year)[d]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// providedHolidays returns the holidays that the providers return for the
+// given year.
+func (c *BusinessCalendar) providedHolidays(year int) map[Date]struct{} {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if days, ok := c.years[year]; ok {
+		return days
+	}
+
+	days := make(map[Date]struct{})
+	for _, p := range c.providers {
+		for _, h := range p.Holidays(year) {
+			days[DateOf(h)] = struct{}{}
+		}
+	}
+
+	if c.years == nil {
+		c.years = make(map[int]map[Date]struct{})
+	}
+	c.years[year] = days
+
+	return days
+}
+
+// IsBusinessDay reports whether the day of t is neither a weekend day nor a
+// holiday.
+func (c *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	return !c.IsWeekend(t) && !c.IsHoliday(t)
+}
+
+// NextBusinessDay returns the same time of day on the first business day after
+// the day of t. It is equivalent to AddBusinessDays(t, 1).
+func (c *BusinessCalendar) NextBusinessDay(t time.Time) time.Time {
+	return c.AddBusinessDays(t, 1)
+}
+
+// AddBusinessDays moves t by n business days, keeping its time of day. A
+// negative n moves t backwards. If the day of t is not a business day, the
+// first step lands on the nearest business day in the direction of n. If the
+// calendar has no business days within five years, t is returned unchanged.
+func (c *BusinessCalendar) AddBusinessDays(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	days := 0
+	for searched := 0; n > 0; searched++ {
+		if searched >= maxBusinessDaySearch {
+			return t
+		}
+
+		days += step
+		if c.IsBusinessDay(t.AddDate(0, 0, days)) {
+			n--
+			searched = 0
+		}
+	}
+
+	return t.AddDate(0, 0, days)
+}
+
+// BusinessDaysBetween returns the number of business days from the day of
+// from up to, but not including, the day of to. If to lies before from, the
+// result is negative.
+func (c *BusinessCalendar) BusinessDaysBetween(from, to time.Time) int {
+	start, end := DateOf(from).utc(), DateOf(to).utc()
+	sign := 1
+	if end.Before(start) {
+		start, end, sign = end, start, -1
+	}
+
+	days := int(end.Sub(start) / (24 * time.Hour))
+	weeks, rest := days/7, days%7
+
+	workdays := 0
+	for _, weekend := range c.weekend {
+		if !weekend {
+			workdays++
+		}
+	}
+
+	count := weeks * workdays
+	for i := 0; i < rest; i++ {
+		if !c.weekend[start.AddDate(0, 0, weeks*7+i).Weekday()] {
+			count++
+		}
+	}
+
+	for h := range c.holidaysBetween(start, end) {
+		if !c.weekend[h.utc().Weekday()] {
+			count--
+		}
+	}
+
+	return sign * count
+}
+
+// holidaysBetween returns the holidays from start up to, but not including,
+// end. Both must be midnight in UTC.
+func (c *BusinessCalendar) holidaysBetween(start, end time.Time) map[Date]struct{} {
+	out := make(map[Date]struct{})
+	add := func(days map[Date]struct{}) {
+		for d := range days {
+			if u := d.utc(); !u.Before(start) && u.Before(end) {
+				out[d] = struct{}{}
+			}
+		}
+	}
+
+	add(c.holidays)
+	if len(c.providers) > 0 {
+		for year := start.Year() - 1; year <= end.Year()+1; year++ {
+			add(c.providedHolidays(year))
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,126 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func newTestCalendar() *timefn.BusinessCalendar {
+	return timefn.NewBusinessCalendar(timefn.WithHolidays(
+		time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.December, 26, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	))
+}
+
+func TestBusinessCalendar_IsBusinessDay(t *testing.T) {
+	cal := newTestCalendar()
+	tokyo := time.FixedZone("JST", 9*3600)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{name: "weekday", t: time.Date(2023, time.December, 22, 12, 0, 0, 0, time.UTC), want: true},
+		{name: "saturday", t: time.Date(2023, time.December, 23, 12, 0, 0, 0, time.UTC), want: false},
+		{name: "holiday", t: time.Date(2023, time.December, 25, 12, 0, 0, 0, time.UTC), want: false},
+		{name: "holiday in other location", t: time.Date(2023, time.December, 25, 8, 0, 0, 0, tokyo), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.IsBusinessDay(tt.t); got != tt.want {
+				t.Errorf("IsBusinessDay(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+
+	var _ timefn.BusinessDays = cal
+}
+
+func TestBusinessCalendar_WithWeekend(t *testing.T) {
+	cal := timefn.NewBusinessCalendar(timefn.WithWeekend(time.Friday, time.Saturday))
+
+	if cal.IsBusinessDay(time.Date(2023, time.December, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected Friday to be a weekend day")
+	}
+
+	if !cal.IsBusinessDay(time.Date(2023, time.December, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected Sunday to be a business day")
+	}
+}
+
+func TestBusinessCalendar_AddBusinessDays(t *testing.T) {
+	cal := newTestCalendar()
+	at := func(m time.Month, d int) time.Time {
+		y := 2023
+		if m == time.January {
+			y = 2024
+		}
+		return time.Date(y, m, d, 15, 30, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		n    int
+		want time.Time
+	}{
+		{name: "zero", t: at(time.December, 23), n: 0, want: at(time.December, 23)},
+		{name: "next day", t: at(time.December, 20), n: 1, want: at(time.December, 21)},
+		{name: "over weekend", t: at(time.December, 22), n: 1, want: at(time.December, 27)},
+		{name: "from weekend", t: at(time.December, 23), n: 1, want: at(time.December, 27)},
+		{name: "over holidays", t: at(time.December, 22), n: 5, want: at(time.January, 3)},
+		{name: "backwards", t: at(time.December, 27), n: -1, want: at(time.December, 22)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.AddBusinessDays(tt.t, tt.n); !got.Equal(tt.want) {
+				t.Errorf("AddBusinessDays(%v, %d) = %v, want %v", tt.t, tt.n, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := cal.NextBusinessDay(at(time.December, 22)), at(time.December, 27); !got.Equal(want) {
+		t.Errorf("NextBusinessDay() = %v, want %v", got, want)
+	}
+}
+
+func TestBusinessCalendar_AddBusinessDays_noBusinessDays(t *testing.T) {
+	cal := timefn.NewBusinessCalendar(timefn.WithWeekend(
+		time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+	))
+	now := time.Date(2023, time.December, 22, 0, 0, 0, 0, time.UTC)
+
+	if got := cal.AddBusinessDays(now, 1); !got.Equal(now) {
+		t.Errorf("expected time to be returned unchanged; got %v", got)
+	}
+}
+
+func TestBusinessCalendar_BusinessDaysBetween(t *testing.T) {
+	cal := newTestCalendar()
+	start := time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+	for days := 0; days < 60; days++ {
+		end := start.AddDate(0, 0, days)
+
+		want := 0
+		for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+			if cal.IsBusinessDay(d) {
+				want++
+			}
+		}
+
+		if got := cal.BusinessDaysBetween(start, end); got != want {
+			t.Errorf("BusinessDaysBetween(%v, %v) = %d, want %d", start, end, got, want)
+		}
+
+		if got := cal.BusinessDaysBetween(end, start); got != -want {
+			t.Errorf("BusinessDaysBetween(%v, %v) = %d, want %d", end, start, got, -want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package timefn
+
+import (
+	"container/list"
+	"slices"
+	"sync"
+)
+
+// CacheEntry is a value stored in a [Cache] together with the period it
+// covers.
+type CacheEntry[V any] struct {
+	Period Period
+	Value  V
+}
+
+// Cache is a least-recently-used cache of values keyed by the periods they
+// cover, such as the results of time-series queries. Besides exact lookups, it
+// reports which parts of a period are not covered by any cached entry, so that
+// only the missing ranges need to be fetched. A Cache is safe for concurrent
+// use. Use [NewCache] to create one.
+type Cache[V any] struct {
+	mux      sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[Period]*list.Element
+}
+
+// NewCache returns a [Cache] that holds at most capacity entries. When a new
+// entry is added to a full cache, the least recently used entry is evicted. A
+// capacity of zero or less means the cache is unbounded.
+func NewCache[V any](capacity int) *Cache[V] {
+	return &Cache[V]{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[Period]*list.Element),
+	}
+}
+
+// cacheKey returns the key of p. Periods that describe the same instants in
+// different locations share the same key.
+func cacheKey(p Period) Period {
+	return p.UTC()
+}
+
+// Put stores the value for the given period, replacing any value that was
+// stored for exactly the same period.
+func (c *Cache[V]) Put(p Period, v V) {
+	key := cacheKey(p)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		e.Value = CacheEntry[V]{Period: p, Value: v}
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(CacheEntry[V]{Period: p, Value: v})
+
+	if c.capacity > 0 && c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, cacheKey(oldest.Value.(CacheEntry[V]).Period))
+	}
+}
+
+// Get returns the value stored for exactly the given period.
+func (c *Cache[V]) Get(p Period) (V, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	e, ok := c.entries[cacheKey(p)]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	c.order.MoveToFront(e)
+	return e.Value.(CacheEntry[V]).Value, true
+}
+
+// Overlapping returns the entries whose periods overlap with p, sorted by
+// [ComparePeriods]. The returned entries count as used.
+func (c *Cache[V]) Overlapping(p Period) []CacheEntry[V] {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	var out []CacheEntry[V]
+	for e := c.order.Front(); e != nil; {
+		next := e.Next()
+		if entry := e.Value.(CacheEntry[V]); entry.Period.OverlapsWith(p) {
+			out = append(out, entry)
+			c.order.MoveToFront(e)
+		}
+		e = next
+	}
+
+	slices.SortStableFunc(out, func(a, b CacheEntry[V]) int {
+		return ComparePeriods(a.Period, b.Period)
+	})
+	return out
+}
+
+// Missing returns the parts of p that are not covered by any cached entry,
+// sorted by their start. It returns nil if p is fully covered. The entries
+// that cover parts of p count as used.
+func (c *Cache[V]) Missing(p Period) []Period {
+	entries := c.Overlapping(p)
+	covered := make([]Period, len(entries))
+	for i, e := range entries {
+		covered[i] = e.Period
+	}
+	return Subtract([]Period{p}, covered)
+}
+
+// Covered returns whether p is fully covered by cached entries.
+func (c *Cache[V]) Covered(p Period) bool {
+	return len(c.Missing(p)) == 0
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[V]) Len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,100 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestCache_Missing(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC)
+	}
+
+	cache := timefn.NewCache[string](0)
+	cache.Put(timefn.Period{Start: at(2), End: at(4)}, "a")
+	cache.Put(timefn.Period{Start: at(3), End: at(6)}, "b")
+	cache.Put(timefn.Period{Start: at(8), End: at(10)}, "c")
+
+	tests := []struct {
+		name  string
+		query timefn.Period
+		want  []timefn.Period
+	}{
+		{
+			name:  "fully covered",
+			query: timefn.Period{Start: at(2), End: at(6)},
+			want:  nil,
+		},
+		{
+			name:  "gaps",
+			query: timefn.Period{Start: at(0), End: at(12)},
+			want: []timefn.Period{
+				{Start: at(0), End: at(2)},
+				{Start: at(6), End: at(8)},
+				{Start: at(10), End: at(12)},
+			},
+		},
+		{
+			name:  "not cached",
+			query: timefn.Period{Start: at(20), End: at(21)},
+			want:  []timefn.Period{{Start: at(20), End: at(21)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cache.Missing(tt.query)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Missing() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Missing()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+
+			if covered := cache.Covered(tt.query); covered != (len(tt.want) == 0) {
+				t.Errorf("Covered() = %v, want %v", covered, len(tt.want) == 0)
+			}
+		})
+	}
+
+	entries := cache.Overlapping(timefn.Period{Start: at(3), End: at(9)})
+	if len(entries) != 3 || entries[0].Value != "a" || entries[1].Value != "b" || entries[2].Value != "c" {
+		t.Errorf("Overlapping() = %v, want entries a, b and c", entries)
+	}
+}
+
+func TestCache_eviction(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC)
+	}
+	p := func(h int) timefn.Period {
+		return timefn.Period{Start: at(h), End: at(h + 1)}
+	}
+
+	cache := timefn.NewCache[int](2)
+	cache.Put(p(1), 1)
+	cache.Put(p(2), 2)
+
+	if v, ok := cache.Get(p(1).In(time.FixedZone("X", 3600))); !ok || v != 1 {
+		t.Fatalf("Get() = %v, %v, want 1, true", v, ok)
+	}
+
+	cache.Put(p(3), 3)
+
+	if _, ok := cache.Get(p(2)); ok {
+		t.Errorf("expected least recently used entry to be evicted")
+	}
+
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 entries; got %d", cache.Len())
+	}
+
+	cache.Put(p(3), 4)
+	if v, _ := cache.Get(p(3)); v != 4 {
+		t.Errorf("expected value to be replaced; got %d", v)
+	}
+}
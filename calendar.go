@@ -0,0 +1,228 @@
+package timefn
+
+import (
+	"fmt"
+	"time"
+)
+
+// Month identifies a calendar month within a specific year. It is used as the
+// element type of [Period.Months] to group periods into monthly buckets.
+type Month struct {
+	Year  int
+	Month time.Month
+}
+
+// MonthOf returns the [Month] that contains the given time, as seen in the
+// location of the time.
+func MonthOf(t time.Time) Month {
+	return Month{Year: t.Year(), Month: t.Month()}
+}
+
+// String returns the month in the form "2006-01".
+func (m Month) String() string {
+	return fmt.Sprintf("%04d-%02d", m.Year, int(m.Month))
+}
+
+// ParseMonth parses a month in the form "2006-01".
+func ParseMonth(s string) (Month, error) {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		return Month{}, fmt.Errorf("invalid month %q", s)
+	}
+	return MonthOf(t), nil
+}
+
+// MarshalText implements [encoding.TextMarshaler] using [Month.String].
+func (m Month) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] using [ParseMonth].
+func (m *Month) UnmarshalText(text []byte) error {
+	parsed, err := ParseMonth(string(text))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Add returns the month that lies n months after m. A negative n returns an
+// earlier month. Unlike [time.Time.AddDate], Add never skips a month because
+// of differing month lengths.
+func (m Month) Add(n int) Month {
+	i := monthIndex(m) + n
+	return Month{Year: floorDiv(i, 12), Month: time.Month(i-floorDiv(i, 12)*12) + 1}
+}
+
+// Sub returns the number of months from o to m.
+func (m Month) Sub(o Month) int {
+	return monthIndex(m) - monthIndex(o)
+}
+
+// Compare returns -1 if m is before o, +1 if m is after o, and 0 if both are
+// the same month.
+func (m Month) Compare(o Month) int {
+	switch d := m.Sub(o); {
+	case d < 0:
+		return -1
+	case d > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Before returns whether m is before o.
+func (m Month) Before(o Month) bool {
+	return m.Compare(o) < 0
+}
+
+// After returns whether m is after o.
+func (m Month) After(o Month) bool {
+	return m.Compare(o) > 0
+}
+
+// Start returns midnight at the first day of the month in loc.
+func (m Month) Start(loc *time.Location) time.Time {
+	return time.Date(m.Year, m.Month, 1, 0, 0, 0, 0, loc)
+}
+
+// Period returns the period from the start of the month up to the start of the
+// next month in UTC. Use [Month.PeriodIn] for other locations.
+func (m Month) Period() Period {
+	return m.PeriodIn(time.UTC)
+}
+
+// PeriodIn returns the period from the start of the month up to the start of
+// the next month in loc.
+func (m Month) PeriodIn(loc *time.Location) Period {
+	return Period{Start: m.Start(loc), End: m.Add(1).Start(loc)}
+}
+
+// Contains returns whether the given time falls into the month, as seen in the
+// location of the time.
+func (m Month) Contains(t time.Time) bool {
+	return MonthOf(t) == m
+}
+
+// Days returns the number of days in the month.
+func (m Month) Days() int {
+	return DaysInMonth(m.Year, m.Month)
+}
+
+// Quarter identifies a calendar quarter (1-4) within a specific year. It is
+// used as the element type of [Period.Quarters] to group periods into
+// quarterly buckets.
+type Quarter struct {
+	Year    int
+	Quarter int
+}
+
+// QuarterOf returns the [Quarter] that contains the given time, as seen in the
+// location of the time.
+func QuarterOf(t time.Time) Quarter {
+	return Quarter{Year: t.Year(), Quarter: (int(t.Month())-1)/3 + 1}
+}
+
+// String returns the quarter in the form "2006-Q1".
+func (q Quarter) String() string {
+	return fmt.Sprintf("%04d-Q%d", q.Year, q.Quarter)
+}
+
+// Week identifies an ISO 8601 week, which starts on Monday, by its
+// week-numbering year and week number. See [WeekISO] for the numbering rules.
+type Week struct {
+	Year int
+	Week int
+}
+
+// WeekOf returns the ISO [Week] that contains the given time, as seen in the
+// location of the time.
+func WeekOf(t time.Time) Week {
+	y, w := t.ISOWeek()
+	return Week{Year: y, Week: w}
+}
+
+// ParseWeek parses a week in the form "2006-W01". It returns an error if the
+// week does not exist in the given year.
+func ParseWeek(s string) (Week, error) {
+	var w Week
+	if n, err := fmt.Sscanf(s, "%04d-W%02d", &w.Year, &w.Week); err != nil || n != 2 || len(s) != 8 {
+		return Week{}, fmt.Errorf("invalid week %q", s)
+	}
+
+	if w.Week < 1 || w.Week > weeksInYear(w.Year) {
+		return Week{}, fmt.Errorf("week %d does not exist in %d", w.Week, w.Year)
+	}
+
+	return w, nil
+}
+
+// weeksInYear returns the number of ISO weeks in the given week-numbering
+// year, which is either 52 or 53.
+func weeksInYear(year int) int {
+	_, w := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return w
+}
+
+// String returns the week in the form "2006-W01".
+func (w Week) String() string {
+	return fmt.Sprintf("%04d-W%02d", w.Year, w.Week)
+}
+
+// MarshalText implements [encoding.TextMarshaler] using [Week.String].
+func (w Week) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] using [ParseWeek].
+func (w *Week) UnmarshalText(text []byte) error {
+	parsed, err := ParseWeek(string(text))
+	if err != nil {
+		return err
+	}
+	*w = parsed
+	return nil
+}
+
+// Start returns midnight at the start of the Monday of the week in loc.
+func (w Week) Start(loc *time.Location) time.Time {
+	first := WeekISO.firstWeekStart(w.Year)
+	return time.Date(first.Year(), first.Month(), first.Day()+7*(w.Week-1), 0, 0, 0, 0, loc)
+}
+
+// Period returns the period from the start of the week up to the start of the
+// next week in UTC. Use [Week.PeriodIn] for other locations.
+func (w Week) Period() Period {
+	return w.PeriodIn(time.UTC)
+}
+
+// PeriodIn returns the period from the start of the week up to the start of
+// the next week in loc.
+func (w Week) PeriodIn(loc *time.Location) Period {
+	return Period{Start: w.Start(loc), End: w.Next().Start(loc)}
+}
+
+// Contains returns whether the given time falls into the week, as seen in the
+// location of the time.
+func (w Week) Contains(t time.Time) bool {
+	return WeekOf(t) == w
+}
+
+// Add returns the week that lies n weeks after w. A negative n returns an
+// earlier week.
+func (w Week) Add(n int) Week {
+	start := w.Start(time.UTC)
+	return WeekOf(time.Date(start.Year(), start.Month(), start.Day()+7*n, 0, 0, 0, 0, time.UTC))
+}
+
+// Next returns the week after w.
+func (w Week) Next() Week {
+	return w.Add(1)
+}
+
+// Prev returns the week before w.
+func (w Week) Prev() Week {
+	return w.Add(-1)
+}
@@ -0,0 +1,159 @@
+package timefn_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestWeek(t *testing.T) {
+	w, err := timefn.ParseWeek("2024-W07")
+	if err != nil {
+		t.Fatalf("ParseWeek() failed: %v", err)
+	}
+
+	if want := (timefn.Week{Year: 2024, Week: 7}); w != want {
+		t.Errorf("ParseWeek() = %v, want %v", w, want)
+	}
+
+	if w.String() != "2024-W07" {
+		t.Errorf("String() = %q, want %q", w.String(), "2024-W07")
+	}
+
+	wantPeriod := timefn.Period{
+		Start: time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.February, 19, 0, 0, 0, 0, time.UTC),
+	}
+	if p := w.Period(); p != wantPeriod {
+		t.Errorf("Period() = %v, want %v", p, wantPeriod)
+	}
+
+	if !w.Contains(time.Date(2024, time.February, 18, 23, 59, 0, 0, time.UTC)) {
+		t.Errorf("expected week to contain Sunday")
+	}
+
+	if w.Contains(time.Date(2024, time.February, 19, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected week not to contain the next Monday")
+	}
+}
+
+func TestWeek_Add(t *testing.T) {
+	tests := []struct {
+		week timefn.Week
+		n    int
+		want timefn.Week
+	}{
+		{week: timefn.Week{Year: 2024, Week: 7}, n: 1, want: timefn.Week{Year: 2024, Week: 8}},
+		{week: timefn.Week{Year: 2020, Week: 52}, n: 1, want: timefn.Week{Year: 2020, Week: 53}},
+		{week: timefn.Week{Year: 2020, Week: 53}, n: 1, want: timefn.Week{Year: 2021, Week: 1}},
+		{week: timefn.Week{Year: 2023, Week: 1}, n: -1, want: timefn.Week{Year: 2022, Week: 52}},
+		{week: timefn.Week{Year: 2023, Week: 10}, n: 104, want: timefn.Week{Year: 2025, Week: 10}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.week.Add(tt.n); got != tt.want {
+			t.Errorf("%v.Add(%d) = %v, want %v", tt.week, tt.n, got, tt.want)
+		}
+	}
+
+	w := timefn.Week{Year: 2021, Week: 1}
+	if w.Next().Prev() != w {
+		t.Errorf("expected Next().Prev() to return the same week")
+	}
+}
+
+func TestParseWeek_invalid(t *testing.T) {
+	for _, s := range []string{"", "2024-07", "2024-W7", "2024-W00", "2023-W53", "2024W07", "2024-W07x"} {
+		if _, err := timefn.ParseWeek(s); err == nil {
+			t.Errorf("expected ParseWeek(%q) to fail", s)
+		}
+	}
+}
+
+func TestWeek_MarshalText(t *testing.T) {
+	counts := map[timefn.Week]int{{Year: 2024, Week: 7}: 3}
+
+	data, err := json.Marshal(counts)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	if string(data) != `{"2024-W07":3}` {
+		t.Errorf("Marshal() = %s", data)
+	}
+
+	var decoded map[timefn.Week]int
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if decoded[timefn.Week{Year: 2024, Week: 7}] != 3 {
+		t.Errorf("Unmarshal() = %v", decoded)
+	}
+}
+
+func TestMonth_Add(t *testing.T) {
+	tests := []struct {
+		month timefn.Month
+		n     int
+		want  timefn.Month
+	}{
+		{month: timefn.Month{Year: 2024, Month: time.January}, n: 1, want: timefn.Month{Year: 2024, Month: time.February}},
+		{month: timefn.Month{Year: 2024, Month: time.December}, n: 1, want: timefn.Month{Year: 2025, Month: time.January}},
+		{month: timefn.Month{Year: 2024, Month: time.January}, n: -1, want: timefn.Month{Year: 2023, Month: time.December}},
+		{month: timefn.Month{Year: 2024, Month: time.March}, n: -27, want: timefn.Month{Year: 2021, Month: time.December}},
+		{month: timefn.Month{Year: 2024, Month: time.March}, n: 0, want: timefn.Month{Year: 2024, Month: time.March}},
+	}
+
+	for _, tt := range tests {
+		got := tt.month.Add(tt.n)
+		if got != tt.want {
+			t.Errorf("%v.Add(%d) = %v, want %v", tt.month, tt.n, got, tt.want)
+		}
+
+		if d := got.Sub(tt.month); d != tt.n {
+			t.Errorf("%v.Sub(%v) = %d, want %d", got, tt.month, d, tt.n)
+		}
+	}
+}
+
+func TestMonth(t *testing.T) {
+	m, err := timefn.ParseMonth("2024-03")
+	if err != nil {
+		t.Fatalf("ParseMonth() failed: %v", err)
+	}
+
+	if want := (timefn.Month{Year: 2024, Month: time.March}); m != want {
+		t.Errorf("ParseMonth() = %v, want %v", m, want)
+	}
+
+	if _, err := timefn.ParseMonth("2024-13"); err == nil {
+		t.Errorf("expected ParseMonth() to fail for month 13")
+	}
+
+	wantPeriod := timefn.Period{
+		Start: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if p := m.Period(); p != wantPeriod {
+		t.Errorf("Period() = %v, want %v", p, wantPeriod)
+	}
+
+	if !m.Contains(time.Date(2024, time.March, 31, 23, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected month to contain March 31")
+	}
+
+	if m.Contains(time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected month not to contain April 1")
+	}
+
+	if !m.Before(m.Add(1)) || !m.After(m.Add(-1)) || m.Compare(m) != 0 {
+		t.Errorf("expected months to be ordered")
+	}
+
+	if m.Days() != 31 {
+		t.Errorf("Days() = %d, want 31", m.Days())
+	}
+}
@@ -0,0 +1,299 @@
+package timefn
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalendarDuration is a duration made of calendar components and a clock
+// component. Unlike [time.Duration], it can represent "1 month" or "1 day"
+// faithfully: the calendar components are applied using calendar arithmetic,
+// so one month after January 15 is always February 15, one month after
+// January 31 is the last day of February, and one day after 09:00 is always
+// 09:00, even across daylight saving time transitions.
+//
+// A CalendarDuration is encoded as an ISO 8601 duration such as "P1Y2M3DT4H",
+// see [CalendarDuration.String] and [ParseCalendarDuration].
+type CalendarDuration struct {
+	Years  int
+	Months int
+	Days   int
+	Clock  time.Duration
+}
+
+// IsZero returns whether all components of the duration are zero.
+func (d CalendarDuration) IsZero() bool {
+	return d == CalendarDuration{}
+}
+
+// AddTo returns t moved by the duration. Years and months are added first and
+// clamp the day to the last day of the resulting month, so one month after
+// January 31 is February 28 or 29, and one year after February 29 is
+// February 28. Days are added after that, keeping the wall-clock time of t,
+// and the clock component is added as elapsed time last.
+func (d CalendarDuration) AddTo(t time.Time) time.Time {
+	date := DateOf(t).addClamped(d, 1)
+	h, m, s := t.Clock()
+	return time.Date(date.Year, date.Month, date.Day, h, m, s, t.Nanosecond(), t.Location()).Add(d.Clock)
+}
+
+// Negate returns the duration with every component negated. Adding the negated
+// duration moves a time backwards, but does not necessarily restore the
+// original time, e.g. because of month-end clamping.
+func (d CalendarDuration) Negate() CalendarDuration {
+	return d.times(-1)
+}
+
+// times returns the duration with every component multiplied by n.
+func (d CalendarDuration) times(n int) CalendarDuration {
+	return CalendarDuration{
+		Years:  n * d.Years,
+		Months: n * d.Months,
+		Days:   n * d.Days,
+		Clock:  time.Duration(n) * d.Clock,
+	}
+}
+
+// String returns the duration as an ISO 8601 duration, e.g. "P1Y2M3DT4H5M6.5S".
+// The zero duration is "PT0S". If all components are negative or zero, the
+// duration is prefixed with "-"; durations with mixed signs negate single
+// components instead, as in "P1M-2D".
+func (d CalendarDuration) String() string {
+	if d.IsZero() {
+		return "PT0S"
+	}
+
+	if d.Years <= 0 && d.Months <= 0 && d.Days <= 0 && d.Clock <= 0 {
+		return "-" + d.Negate().String()
+	}
+
+	var b strings.Builder
+	b.WriteString("P")
+	for _, c := range []struct {
+		n    int
+		unit byte
+	}{{d.Years, 'Y'}, {d.Months, 'M'}, {d.Days, 'D'}} {
+		if c.n != 0 {
+			b.WriteString(strconv.Itoa(c.n))
+			b.WriteByte(c.unit)
+		}
+	}
+
+	if d.Clock == 0 {
+		return b.String()
+	}
+
+	b.WriteString("T")
+
+	sign, clock := "", d.Clock
+	if clock < 0 {
+		sign, clock = "-", -clock
+	}
+
+	if h := clock / time.Hour; h > 0 {
+		fmt.Fprintf(&b, "%s%dH", sign, h)
+	}
+	if m := clock % time.Hour / time.Minute; m > 0 {
+		fmt.Fprintf(&b, "%s%dM", sign, m)
+	}
+	if s := clock % time.Minute; s > 0 {
+		secs := strconv.FormatInt(int64(s/time.Second), 10)
+		if ns := s % time.Second; ns > 0 {
+			secs += strings.TrimRight(fmt.Sprintf(".%09d", ns), "0")
+		}
+		fmt.Fprintf(&b, "%s%sS", sign, secs)
+	}
+
+	return b.String()
+}
+
+// MarshalText implements [encoding.TextMarshaler] using
+// [CalendarDuration.String].
+func (d CalendarDuration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] using
+// [ParseCalendarDuration].
+func (d *CalendarDuration) UnmarshalText(text []byte) error {
+	parsed, err := ParseCalendarDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// ParseCalendarDuration parses an ISO 8601 duration of the form
+// "PnYnMnDTnHnMnS" or "PnW" into a [CalendarDuration]. Only the smallest
+// component may have a fraction, and only if it is part of the time component
+// (hours, minutes or seconds). The components must appear in this order and
+// at most once each. An optional leading "-" negates the duration.
+// Single components may also be negated, as in "P1M-2D", which is how
+// [CalendarDuration.String] formats durations with mixed signs.
+func ParseCalendarDuration(s string) (CalendarDuration, error) {
+	var out CalendarDuration
+
+	input := s
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return out, fmt.Errorf("invalid ISO 8601 duration %q", input)
+	}
+	s = s[1:]
+
+	var inTime, fraction bool
+	last := -1
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return out, fmt.Errorf("invalid ISO 8601 duration %q", input)
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		if fraction {
+			return out, fmt.Errorf("invalid ISO 8601 duration %q: only the smallest component may have a fraction", input)
+		}
+
+		sign := ""
+		if s[0] == '-' {
+			sign, s = "-", s[1:]
+		}
+
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i <= 0 {
+			return out, fmt.Errorf("invalid ISO 8601 duration %q", input)
+		}
+
+		num := strings.Replace(s[:i], ",", ".", 1)
+		unit := s[i]
+		s = s[i+1:]
+
+		designators := "YMWD"
+		if inTime {
+			designators = "HMS"
+		}
+		idx := strings.IndexByte(designators, unit)
+		if idx < 0 {
+			return out, fmt.Errorf("invalid ISO 8601 duration %q: unknown unit %q", input, unit)
+		}
+		if inTime {
+			idx += 4
+		}
+		if idx <= last {
+			return out, fmt.Errorf("invalid ISO 8601 duration %q: unit %q is repeated or out of order", input, unit)
+		}
+		last = idx
+
+		if strings.Contains(num, ".") {
+			if !inTime {
+				return out, fmt.Errorf("invalid ISO 8601 duration %q: fractions are only supported for hours, minutes and seconds", input)
+			}
+			fraction = true
+		}
+
+		if !inTime {
+			n, err := strconv.Atoi(sign + num)
+			if err != nil {
+				return out, fmt.Errorf("invalid ISO 8601 duration %q: %w", input, err)
+			}
+
+			switch unit {
+			case 'Y':
+				out.Years += n
+			case 'M':
+				out.Months += n
+			case 'W':
+				out.Days += 7 * n
+			case 'D':
+				out.Days += n
+			}
+			continue
+		}
+
+		size := time.Second
+		switch unit {
+		case 'H':
+			size = time.Hour
+		case 'M':
+			size = time.Minute
+		}
+
+		d, err := parseDecimalDuration(num, size)
+		if err != nil {
+			return out, fmt.Errorf("invalid ISO 8601 duration %q: %w", input, err)
+		}
+		if sign != "" {
+			d = -d
+		}
+		out.Clock += d
+	}
+
+	if negative {
+		out.Years, out.Months, out.Days, out.Clock = -out.Years, -out.Months, -out.Days, -out.Clock
+	}
+
+	return out, nil
+}
+
+// parseDecimalDuration parses the unsigned decimal number s, which may have a
+// fraction separated by ".", as a multiple of unit. The fraction is parsed as
+// an integer and scaled exactly instead of going through floating point, so
+// "1.001" seconds are exactly 1001 milliseconds. Fraction digits below a
+// nanosecond are truncated.
+func parseDecimalDuration(s string, unit time.Duration) (time.Duration, error) {
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" || strings.Contains(s, ".") && frac == "" {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt64/int64(unit) {
+		return 0, fmt.Errorf("number %q out of range", s)
+	}
+	d := time.Duration(n) * unit
+
+	// Only the first 18 digits can matter, since even a fraction of an hour
+	// is less than 10^13 nanoseconds, and 10^18 still fits into a uint64.
+	if len(frac) > 18 {
+		frac = frac[:18]
+	}
+	if frac == "" {
+		return d, nil
+	}
+
+	f, err := strconv.ParseUint(frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+
+	scale := uint64(1)
+	for range frac {
+		scale *= 10
+	}
+
+	// f < scale, so f*unit/scale < unit and the division cannot overflow.
+	hi, lo := bits.Mul64(f, uint64(unit))
+	ns, _ := bits.Div64(hi, lo, scale)
+
+	if d > math.MaxInt64-time.Duration(ns) {
+		return 0, fmt.Errorf("number %q out of range", s)
+	}
+
+	return d + time.Duration(ns), nil
+}
@@ -0,0 +1,202 @@
+package timefn_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarDuration_AddTo(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		d    timefn.CalendarDuration
+		t    time.Time
+		want time.Time
+	}{
+		{
+			name: "month",
+			d:    timefn.CalendarDuration{Months: 1},
+			t:    time.Date(2023, time.January, 15, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.February, 15, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day across dst",
+			d:    timefn.CalendarDuration{Days: 1},
+			t:    time.Date(2023, time.March, 25, 9, 0, 0, 0, berlin),
+			want: time.Date(2023, time.March, 26, 9, 0, 0, 0, berlin),
+		},
+		{
+			name: "all components",
+			d:    timefn.CalendarDuration{Years: 1, Months: 2, Days: 3, Clock: 90 * time.Minute},
+			t:    time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, time.March, 4, 1, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "negated",
+			d:    timefn.CalendarDuration{Months: 1, Clock: time.Hour}.Negate(),
+			t:    time.Date(2023, time.March, 15, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.February, 15, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "month from month end",
+			d:    timefn.CalendarDuration{Months: 1},
+			t:    time.Date(2023, time.January, 31, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.February, 28, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "month from month end in leap year",
+			d:    timefn.CalendarDuration{Months: 1},
+			t:    time.Date(2024, time.January, 31, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "year from leap day",
+			d:    timefn.CalendarDuration{Years: 1},
+			t:    time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2025, time.February, 28, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "month and day from month end",
+			d:    timefn.CalendarDuration{Months: 1, Days: 1},
+			t:    time.Date(2023, time.January, 31, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.March, 1, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.AddTo(tt.t); !got.Equal(tt.want) {
+				t.Errorf("AddTo() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarDuration_Negate(t *testing.T) {
+	d := timefn.CalendarDuration{Years: 1, Months: -2, Days: 3, Clock: time.Hour}
+	assert.Equal(t, timefn.CalendarDuration{Years: -1, Months: 2, Days: -3, Clock: -time.Hour}, d.Negate())
+	assert.Equal(t, d, d.Negate().Negate())
+}
+
+func TestCalendarDuration_String(t *testing.T) {
+	tests := []struct {
+		d    timefn.CalendarDuration
+		want string
+	}{
+		{d: timefn.CalendarDuration{}, want: "PT0S"},
+		{d: timefn.CalendarDuration{Years: 1, Months: 2, Days: 3}, want: "P1Y2M3D"},
+		{d: timefn.CalendarDuration{Clock: 4*time.Hour + 5*time.Minute + 6500*time.Millisecond}, want: "PT4H5M6.5S"},
+		{d: timefn.CalendarDuration{Days: 1, Clock: time.Nanosecond}, want: "P1DT0.000000001S"},
+		{d: timefn.CalendarDuration{Months: -1, Clock: -time.Hour}, want: "-P1MT1H"},
+		{d: timefn.CalendarDuration{Months: 1, Days: -2}, want: "P1M-2D"},
+		{d: timefn.CalendarDuration{Days: 1, Clock: -90 * time.Minute}, want: "P1DT-1H-30M"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.d.String())
+
+			parsed, err := timefn.ParseCalendarDuration(tt.want)
+			if err != nil {
+				t.Fatalf("ParseCalendarDuration(%q) failed: %v", tt.want, err)
+			}
+			assert.Equal(t, tt.d, parsed)
+		})
+	}
+}
+
+func TestParseCalendarDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    timefn.CalendarDuration
+		wantErr bool
+	}{
+		{input: "P2W", want: timefn.CalendarDuration{Days: 14}},
+		{input: "PT1,5H", want: timefn.CalendarDuration{Clock: 90 * time.Minute}},
+		{input: "-P1D", want: timefn.CalendarDuration{Days: -1}},
+		{input: "P", wantErr: true},
+		{input: "P1", wantErr: true},
+		{input: "P-D", wantErr: true},
+		{input: "P1.5D", wantErr: true},
+		{input: "PT1.5H30M", wantErr: true},
+		{input: "1D", wantErr: true},
+		{input: "PT1.001S", want: timefn.CalendarDuration{Clock: 1001 * time.Millisecond}},
+		{input: "PT0.000000001S", want: timefn.CalendarDuration{Clock: time.Nanosecond}},
+		{input: "PT0.0000000000005H", want: timefn.CalendarDuration{Clock: time.Nanosecond}},
+		{input: "P1W2D", want: timefn.CalendarDuration{Days: 9}},
+		{input: "PT1S1H", wantErr: true},
+		{input: "P1D1D", wantErr: true},
+		{input: "P1D1M", wantErr: true},
+		{input: "PT1M1M", wantErr: true},
+		{input: "PT.5S", wantErr: true},
+		{input: "PT1.S", wantErr: true},
+		{input: "PT1.2.3S", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := timefn.ParseCalendarDuration(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseCalendarDuration(%q) should fail", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCalendarDuration(%q) failed: %v", tt.input, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCalendarDuration_MarshalText_roundTrip(t *testing.T) {
+	tests := []timefn.CalendarDuration{
+		{Clock: 1001 * time.Millisecond},
+		{Clock: time.Nanosecond},
+		{Clock: 999999999 * time.Nanosecond},
+		{Years: 1, Months: 2, Days: 3, Clock: 4*time.Hour + 5*time.Minute + 6*time.Second + 7},
+		{Months: 1, Days: -2},
+		{Days: -1, Clock: -1234567891 * time.Nanosecond},
+	}
+
+	for _, d := range tests {
+		b, err := d.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() failed: %v", err)
+		}
+
+		var got timefn.CalendarDuration
+		if err := got.UnmarshalText(b); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", b, err)
+		}
+
+		if got != d {
+			t.Errorf("UnmarshalText(%q) = %#v, want %#v", b, got, d)
+		}
+	}
+}
+
+func TestCalendarDuration_JSON(t *testing.T) {
+	d := timefn.CalendarDuration{Months: 3, Clock: 12 * time.Hour}
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	assert.Equal(t, `"P3MT12H"`, string(b))
+
+	var got timefn.CalendarDuration
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	assert.Equal(t, d, got)
+}
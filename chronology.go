@@ -0,0 +1,147 @@
+package timefn
+
+import "time"
+
+// unixEpochJDN is the Julian Day Number of 1970-01-01.
+const unixEpochJDN = 2440588
+
+// Chronology is a calendar system that maps instants to dates. It allows
+// month and year boundaries to be computed in calendars other than the
+// Gregorian one, using [StartOfMonthIn], [EndOfMonthIn], [StartOfYearIn] and
+// [EndOfYearIn]. Months and days are numbered starting at 1.
+type Chronology interface {
+	// Date returns the date of t in the calendar system, as seen in the
+	// location of t.
+	Date(t time.Time) (year, month, day int)
+
+	// Time returns midnight at the start of the given date in loc. Month and
+	// day values outside their usual ranges are normalized, like [time.Date]
+	// does.
+	Time(year, month, day int, loc *time.Location) time.Time
+}
+
+// ISO is the proleptic Gregorian calendar as used by the [time] package.
+type ISO struct{}
+
+// Date implements [Chronology].
+func (ISO) Date(t time.Time) (year, month, day int) {
+	y, m, d := t.Date()
+	return y, int(m), d
+}
+
+// Time implements [Chronology].
+func (ISO) Time(year, month, day int, loc *time.Location) time.Time {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// Julian is the proleptic Julian calendar, which differs from the Gregorian
+// calendar only by its leap year rule.
+type Julian struct{}
+
+// Date implements [Chronology].
+func (Julian) Date(t time.Time) (year, month, day int) {
+	c := julianDayNumber(t) + 32082
+	d := floorDiv(4*c+3, 1461)
+	e := c - floorDiv(1461*d, 4)
+	m := floorDiv(5*e+2, 153)
+
+	day = e - floorDiv(153*m+2, 5) + 1
+	month = m + 3 - 12*(m/10)
+	year = d - 4800 + m/10
+	return
+}
+
+// Time implements [Chronology].
+func (Julian) Time(year, month, day int, loc *time.Location) time.Time {
+	year, month = normalizeMonth(year, month)
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	jdn := 1 + floorDiv(153*m+2, 5) + 365*y + floorDiv(y, 4) - 32083
+	return fromJulianDayNumber(jdn+day-1, loc)
+}
+
+// TabularHijri is the arithmetical Islamic calendar with the civil epoch
+// (16 July 622 Julian) and the common leap year cycle of 11 leap years in 30.
+// It can differ by a day or two from calendars that rely on observation of the
+// crescent moon.
+type TabularHijri struct{}
+
+const hijriEpochJDN = 1948440
+
+// Date implements [Chronology].
+func (h TabularHijri) Date(t time.Time) (year, month, day int) {
+	jdn := julianDayNumber(t)
+	year = floorDiv(30*(jdn-hijriEpochJDN)+10646, 10631)
+
+	month = 1
+	for month < 12 && hijriDayNumber(year, month+1, 1) <= jdn {
+		month++
+	}
+	day = jdn - hijriDayNumber(year, month, 1) + 1
+	return
+}
+
+// Time implements [Chronology].
+func (TabularHijri) Time(year, month, day int, loc *time.Location) time.Time {
+	year, month = normalizeMonth(year, month)
+	return fromJulianDayNumber(hijriDayNumber(year, month, 1)+day-1, loc)
+}
+
+func hijriDayNumber(year, month, day int) int {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + floorDiv(3+11*year, 30) + hijriEpochJDN - 1
+}
+
+// StartOfMonthIn returns midnight at the start of the month of t in the given
+// calendar system. The location of t is preserved.
+func StartOfMonthIn(c Chronology, t time.Time) time.Time {
+	y, m, _ := c.Date(t)
+	return c.Time(y, m, 1, t.Location())
+}
+
+// EndOfMonthIn returns the last nanosecond of the month of t in the given
+// calendar system. The location of t is preserved.
+func EndOfMonthIn(c Chronology, t time.Time) time.Time {
+	y, m, _ := c.Date(t)
+	return c.Time(y, m+1, 1, t.Location()).Add(-time.Nanosecond)
+}
+
+// StartOfYearIn returns midnight at the start of the year of t in the given
+// calendar system. The location of t is preserved.
+func StartOfYearIn(c Chronology, t time.Time) time.Time {
+	y, _, _ := c.Date(t)
+	return c.Time(y, 1, 1, t.Location())
+}
+
+// EndOfYearIn returns the last nanosecond of the year of t in the given
+// calendar system. The location of t is preserved.
+func EndOfYearIn(c Chronology, t time.Time) time.Time {
+	y, _, _ := c.Date(t)
+	return c.Time(y+1, 1, 1, t.Location()).Add(-time.Nanosecond)
+}
+
+func julianDayNumber(t time.Time) int {
+	y, m, d := t.Date()
+	days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+	return int(days) + unixEpochJDN
+}
+
+func fromJulianDayNumber(jdn int, loc *time.Location) time.Time {
+	return time.Date(1970, time.January, 1+jdn-unixEpochJDN, 0, 0, 0, 0, loc)
+}
+
+// normalizeMonth normalizes a month outside 1..12 into the adjacent years of
+// a calendar with twelve months.
+func normalizeMonth(year, month int) (int, int) {
+	m := month - 1
+	year += floorDiv(m, 12)
+	return year, m - floorDiv(m, 12)*12 + 1
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
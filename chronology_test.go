@@ -0,0 +1,146 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestChronology_Date(t *testing.T) {
+	tests := []struct {
+		name             string
+		chronology       timefn.Chronology
+		t                time.Time
+		year, month, day int
+	}{
+		{
+			name:       "ISO",
+			chronology: timefn.ISO{},
+			t:          time.Date(2023, time.July, 19, 15, 0, 0, 0, time.UTC),
+			year:       2023, month: 7, day: 19,
+		},
+		{
+			name:       "Julian",
+			chronology: timefn.Julian{},
+			t:          time.Date(2023, time.July, 19, 15, 0, 0, 0, time.UTC),
+			year:       2023, month: 7, day: 6,
+		},
+		{
+			name:       "Julian, Gregorian reform",
+			chronology: timefn.Julian{},
+			t:          time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC),
+			year:       1582, month: 10, day: 5,
+		},
+		{
+			name:       "Hijri, new year 1445",
+			chronology: timefn.TabularHijri{},
+			t:          time.Date(2023, time.July, 19, 15, 0, 0, 0, time.UTC),
+			year:       1445, month: 1, day: 1,
+		},
+		{
+			name:       "Hijri, end of 1444",
+			chronology: timefn.TabularHijri{},
+			t:          time.Date(2023, time.July, 18, 15, 0, 0, 0, time.UTC),
+			year:       1444, month: 12, day: 29,
+		},
+		{
+			name:       "Hijri, Ramadan 1444",
+			chronology: timefn.TabularHijri{},
+			t:          time.Date(2023, time.March, 23, 0, 0, 0, 0, time.UTC),
+			year:       1444, month: 9, day: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y, m, d := tt.chronology.Date(tt.t)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("Date() = %d-%d-%d, want %d-%d-%d", y, m, d, tt.year, tt.month, tt.day)
+			}
+
+			back := tt.chronology.Time(y, m, d, tt.t.Location())
+			if !back.Equal(timefn.StartOfDay(tt.t)) {
+				t.Errorf("Time(%d, %d, %d) = %v, want %v", y, m, d, back, timefn.StartOfDay(tt.t))
+			}
+		})
+	}
+}
+
+func TestChronology_roundTrip(t *testing.T) {
+	chronologies := map[string]timefn.Chronology{
+		"ISO":          timefn.ISO{},
+		"Julian":       timefn.Julian{},
+		"TabularHijri": timefn.TabularHijri{},
+	}
+
+	for name, c := range chronologies {
+		t.Run(name, func(t *testing.T) {
+			day := time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+			for i := 0; i < 80000; i += 7 {
+				d := day.AddDate(0, 0, i)
+				y, m, dd := c.Date(d)
+				if got := c.Time(y, m, dd, time.UTC); !got.Equal(d) {
+					t.Fatalf("Time(Date(%v)) = %v", d, got)
+				}
+			}
+		})
+	}
+}
+
+func TestStartOfMonthIn(t *testing.T) {
+	tm := time.Date(2023, time.July, 25, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		chronology timefn.Chronology
+		wantStart  time.Time
+		wantEnd    time.Time
+	}{
+		{
+			name:       "ISO",
+			chronology: timefn.ISO{},
+			wantStart:  timefn.StartOfMonth(tm),
+			wantEnd:    timefn.EndOfMonth(tm),
+		},
+		{
+			name:       "Julian",
+			chronology: timefn.Julian{},
+			wantStart:  time.Date(2023, time.July, 14, 0, 0, 0, 0, time.UTC),
+			wantEnd:    time.Date(2023, time.August, 14, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			name:       "TabularHijri",
+			chronology: timefn.TabularHijri{},
+			wantStart:  time.Date(2023, time.July, 19, 0, 0, 0, 0, time.UTC),
+			wantEnd:    time.Date(2023, time.August, 18, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.StartOfMonthIn(tt.chronology, tm); !got.Equal(tt.wantStart) {
+				t.Errorf("StartOfMonthIn() = %v, want %v", got, tt.wantStart)
+			}
+			if got := timefn.EndOfMonthIn(tt.chronology, tm); !got.Equal(tt.wantEnd) {
+				t.Errorf("EndOfMonthIn() = %v, want %v", got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestStartOfYearIn(t *testing.T) {
+	tm := time.Date(2023, time.July, 25, 15, 30, 0, 0, time.UTC)
+
+	if got, want := timefn.StartOfYearIn(timefn.ISO{}, tm), timefn.StartOfYear(tm); !got.Equal(want) {
+		t.Errorf("StartOfYearIn(ISO) = %v, want %v", got, want)
+	}
+
+	if got, want := timefn.StartOfYearIn(timefn.Julian{}, tm), time.Date(2023, time.January, 14, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfYearIn(Julian) = %v, want %v", got, want)
+	}
+
+	if got, want := timefn.EndOfYearIn(timefn.TabularHijri{}, tm), time.Date(2024, time.July, 8, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond); !got.Equal(want) {
+		t.Errorf("EndOfYearIn(TabularHijri) = %v, want %v", got, want)
+	}
+}
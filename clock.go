@@ -0,0 +1,153 @@
+package timefn
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Now returns the current time. It is used by all helpers in this package that
+// work relative to the current time, and defaults to [time.Now]. Tests may
+// replace Now to freeze or control the clock, e.g. using [UseClock]. To
+// freeze the time for a single request instead, see [WithNow].
+var Now = time.Now
+
+// Clock provides the current time. Code that depends on the current time can
+// accept a Clock to make it testable: [RealClock] reads the system clock and
+// [FakeClock] is controlled manually.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+
+	// Until returns the duration until t.
+	Until(t time.Time) time.Duration
+}
+
+// RealClock is a [Clock] that reads the system clock.
+type RealClock struct{}
+
+// Now returns [time.Now].
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// Since returns [time.Since] of t.
+func (RealClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+// Until returns [time.Until] of t.
+func (RealClock) Until(t time.Time) time.Duration {
+	return time.Until(t)
+}
+
+// FakeClock is a [Clock] whose time only changes when it is set or advanced.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mux sync.RWMutex
+	now time.Time
+}
+
+// NewFakeClock returns a [FakeClock] that is set to now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	return c.now
+}
+
+// Since returns the duration from t until the time of the clock.
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Until returns the duration from the time of the clock until t.
+func (c *FakeClock) Until(t time.Time) time.Duration {
+	return t.Sub(c.Now())
+}
+
+// Set sets the clock to t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.now = t
+}
+
+// Advance moves the clock forward by d, or backwards if d is negative, and
+// returns the new time.
+func (c *FakeClock) Advance(d time.Duration) time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.now = c.now.Add(d)
+	return c.now
+}
+
+// UseClock replaces [Now] with the Now method of c, so that all helpers in
+// this package that work relative to the current time use the clock. It
+// returns a function that restores the previous [Now], which is typically
+// deferred in tests:
+//
+//	clock := timefn.NewFakeClock(start)
+//	defer timefn.UseClock(clock)()
+func UseClock(c Clock) (restore func()) {
+	prev := Now
+	Now = c.Now
+	return func() { Now = prev }
+}
+
+type nowContextKey struct{}
+
+// WithNow returns a copy of ctx that carries the time t as the current time,
+// which is returned by [NowFromContext]. It freezes the time for a single
+// request or job, e.g. to replay an event idempotently or to backdate an
+// administrative action, without replacing the global [Now].
+func WithNow(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, nowContextKey{}, t)
+}
+
+// NowFromContext returns the time carried by ctx, see [WithNow], or the
+// current time as returned by [Now] if ctx carries no time. The helpers of
+// this package that accept a context read the current time from
+// NowFromContext. These are [SleepUntil] and [Period.Context], as well as the
+// Context variants of the now-relative helpers, such as [WithinLastContext],
+// [IsExpiredContext], [Period.IsActiveContext], [TodayContext] and
+// [ResolveRangeExprContext].
+func NowFromContext(ctx context.Context) time.Time {
+	if t, ok := ctx.Value(nowContextKey{}).(time.Time); ok {
+		return t
+	}
+	return Now()
+}
+
+// ClockFromContext returns a [Clock] that reads the current time from
+// [NowFromContext]. It allows to pass the time carried by ctx to helpers that
+// accept a Clock, such as [TodayOn]:
+//
+//	today := timefn.TodayOn(timefn.ClockFromContext(ctx), loc)
+func ClockFromContext(ctx context.Context) Clock {
+	if t, ok := ctx.Value(nowContextKey{}).(time.Time); ok {
+		return fixedClock(t)
+	}
+	return nowClock{}
+}
+
+// fixedClock is a [Clock] that always returns the same time.
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time                  { return time.Time(c) }
+func (c fixedClock) Since(t time.Time) time.Duration { return time.Time(c).Sub(t) }
+func (c fixedClock) Until(t time.Time) time.Duration { return t.Sub(time.Time(c)) }
+
+// nowClock is a [Clock] that reads the current time from [Now].
+type nowClock struct{}
+
+func (nowClock) Now() time.Time                  { return Now() }
+func (nowClock) Since(t time.Time) time.Duration { return Now().Sub(t) }
+func (nowClock) Until(t time.Time) time.Duration { return TimeUntil(t) }
@@ -0,0 +1,172 @@
+package timefn_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	clock := timefn.NewFakeClock(start)
+
+	if got := clock.Now(); !got.Equal(start) {
+		t.Errorf("Now() = %v; want %v", got, start)
+	}
+
+	if got := clock.Advance(90 * time.Minute); !got.Equal(start.Add(90 * time.Minute)) {
+		t.Errorf("Advance() = %v; want %v", got, start.Add(90*time.Minute))
+	}
+
+	if got := clock.Since(start); got != 90*time.Minute {
+		t.Errorf("Since() = %v; want %v", got, 90*time.Minute)
+	}
+
+	if got := clock.Until(start.Add(2 * time.Hour)); got != 30*time.Minute {
+		t.Errorf("Until() = %v; want %v", got, 30*time.Minute)
+	}
+
+	later := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	clock.Set(later)
+	if got := clock.Now(); !got.Equal(later) {
+		t.Errorf("Now() after Set() = %v; want %v", got, later)
+	}
+}
+
+func TestRealClock(t *testing.T) {
+	var clock timefn.Clock = timefn.RealClock{}
+
+	before := time.Now()
+	now := clock.Now()
+	if now.Before(before) || clock.Since(before) < 0 || clock.Until(before) > 0 {
+		t.Errorf("RealClock does not follow the system clock")
+	}
+}
+
+func TestUseClock(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	clock := timefn.NewFakeClock(start)
+
+	restore := timefn.UseClock(clock)
+
+	if !timefn.IsExpired(start) {
+		t.Errorf("IsExpired() should use the clock")
+	}
+
+	clock.Advance(time.Hour)
+	if got := timefn.TimeUntil(start.Add(2 * time.Hour)); got != time.Hour {
+		t.Errorf("TimeUntil() = %v; want %v", got, time.Hour)
+	}
+
+	restore()
+
+	if got := timefn.Now(); got.Year() == 2023 {
+		t.Errorf("Now() should be restored; got %v", got)
+	}
+}
+
+func TestNowFromContext(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	defer timefn.UseClock(timefn.NewFakeClock(now))()
+
+	if got := timefn.NowFromContext(context.Background()); !got.Equal(now) {
+		t.Errorf("NowFromContext() without time = %v; want %v", got, now)
+	}
+
+	backdated := now.AddDate(0, 0, -3)
+	ctx := timefn.WithNow(context.Background(), backdated)
+	if got := timefn.NowFromContext(ctx); !got.Equal(backdated) {
+		t.Errorf("NowFromContext() = %v; want %v", got, backdated)
+	}
+
+	clock := timefn.ClockFromContext(ctx)
+	if got := clock.Now(); !got.Equal(backdated) {
+		t.Errorf("ClockFromContext().Now() = %v; want %v", got, backdated)
+	}
+	if got := clock.Since(backdated.Add(-time.Hour)); got != time.Hour {
+		t.Errorf("ClockFromContext().Since() = %v; want %v", got, time.Hour)
+	}
+	if got, want := timefn.TodayOn(clock, time.UTC), timefn.UnitDay.Period(backdated); got != want {
+		t.Errorf("TodayOn() = %v; want %v", got, want)
+	}
+
+	if got := timefn.ClockFromContext(context.Background()).Until(now.Add(time.Hour)); got != time.Hour {
+		t.Errorf("ClockFromContext().Until() without time = %v; want %v", got, time.Hour)
+	}
+}
+
+func TestPeriod_Context_withNow(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	window := timefn.Period{Start: now, End: now.Add(time.Hour)}
+
+	ctx, cancel := window.Context(timefn.WithNow(context.Background(), now.Add(-time.Minute)))
+	defer cancel()
+	if cause := context.Cause(ctx); !errors.Is(cause, timefn.ErrPeriodNotStarted) {
+		t.Errorf("Cause() = %v; want %v", cause, timefn.ErrPeriodNotStarted)
+	}
+
+	ctx, cancel = window.Context(timefn.WithNow(context.Background(), now.Add(time.Minute)))
+	defer cancel()
+	if err := ctx.Err(); err != nil {
+		t.Errorf("Err() = %v; want <nil>", err)
+	}
+}
+
+func TestNowFromContext_helpers(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	defer timefn.UseClock(timefn.NewFakeClock(now))()
+
+	backdated := now.AddDate(0, 0, -3)
+	ctx := timefn.WithNow(context.Background(), backdated)
+	p := timefn.Period{Start: backdated.Add(-time.Hour), End: backdated.Add(time.Hour)}
+
+	if !timefn.WithinLastContext(ctx, backdated.Add(-time.Minute), time.Hour) || timefn.WithinLast(backdated.Add(-time.Minute), time.Hour) {
+		t.Errorf("WithinLastContext() should use the time of the context")
+	}
+	if !timefn.WithinNextContext(ctx, backdated.Add(time.Minute), time.Hour) || timefn.WithinNext(backdated.Add(time.Minute), time.Hour) {
+		t.Errorf("WithinNextContext() should use the time of the context")
+	}
+	if timefn.IsExpiredContext(ctx, p.End) || !timefn.IsExpired(p.End) {
+		t.Errorf("IsExpiredContext() should use the time of the context")
+	}
+	if got := timefn.TimeUntilContext(ctx, p.End); got != time.Hour {
+		t.Errorf("TimeUntilContext() = %v; want %v", got, time.Hour)
+	}
+
+	if !p.IsActiveContext(ctx) || p.IsActive() {
+		t.Errorf("IsActiveContext() should use the time of the context")
+	}
+	if p.IsOverContext(ctx) || !p.IsOver() {
+		t.Errorf("IsOverContext() should use the time of the context")
+	}
+	if got := p.StartsInContext(ctx); got != -time.Hour {
+		t.Errorf("StartsInContext() = %v; want %v", got, -time.Hour)
+	}
+	if got := p.EndsInContext(ctx); got != time.Hour {
+		t.Errorf("EndsInContext() = %v; want %v", got, time.Hour)
+	}
+
+	day := timefn.UnitDay.Period(backdated)
+	if got := timefn.TodayContext(ctx, time.UTC); got != day {
+		t.Errorf("TodayContext() = %v; want %v", got, day)
+	}
+	if got, want := timefn.YesterdayContext(ctx, time.UTC), timefn.UnitDay.Period(backdated.AddDate(0, 0, -1)); got != want {
+		t.Errorf("YesterdayContext() = %v; want %v", got, want)
+	}
+	if got, want := timefn.TomorrowContext(ctx, time.UTC), timefn.UnitDay.Period(backdated.AddDate(0, 0, 1)); got != want {
+		t.Errorf("TomorrowContext() = %v; want %v", got, want)
+	}
+	if got := timefn.TodayContext(context.Background(), time.UTC); got != timefn.Today(time.UTC) {
+		t.Errorf("TodayContext() without time = %v; want %v", got, timefn.Today(time.UTC))
+	}
+
+	if got, err := timefn.ResolveRangeExprContext(ctx, "now/d", time.UTC); err != nil || got != day {
+		t.Errorf("ResolveRangeExprContext() = %v, %v; want %v", got, err, day)
+	}
+	if got, err := timefn.ResolveInstantExprContext(ctx, "now-1h", time.UTC); err != nil || !got.Equal(backdated.Add(-time.Hour)) {
+		t.Errorf("ResolveInstantExprContext() = %v, %v; want %v", got, err, backdated.Add(-time.Hour))
+	}
+}
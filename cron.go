@@ -0,0 +1,240 @@
+package timefn
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var cronWeekdayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// CronSchedule is a parsed cron expression. Use [ParseCron] to create one.
+type CronSchedule struct {
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+
+	// anyDay and anyWeekday record whether the day-of-month and day-of-week
+	// fields start with "*", as in "*" or "*/2". Like in Vixie cron, such a
+	// field is considered unrestricted, and only if both fields are
+	// restricted, a day matches if either of them matches.
+	anyDay     bool
+	anyWeekday bool
+}
+
+// ParseCron parses a standard five-field cron expression of the form
+// "minute hour day-of-month month day-of-week". Fields support "*", values,
+// ranges ("1-5"), lists ("1,15"), steps ("*/15", "0-30/10") and the names of
+// months and weekdays ("JAN", "MON"). Sunday is both 0 and 7. The macros
+// "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight" and
+// "@hourly" are supported as well.
+func ParseCron(expr string) (CronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return CronSchedule{}, fmt.Errorf("expected 5 fields; got %d", len(fields))
+	}
+
+	var (
+		s   CronSchedule
+		err error
+	)
+
+	if s.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return CronSchedule{}, fmt.Errorf("minute: %w", err)
+	}
+
+	if s.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return CronSchedule{}, fmt.Errorf("hour: %w", err)
+	}
+
+	if s.days, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return CronSchedule{}, fmt.Errorf("day of month: %w", err)
+	}
+
+	if s.months, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return CronSchedule{}, fmt.Errorf("month: %w", err)
+	}
+
+	if s.weekdays, err = parseCronField(fields[4], 0, 7, cronWeekdayNames); err != nil {
+		return CronSchedule{}, fmt.Errorf("day of week: %w", err)
+	}
+
+	if s.weekdays&(1<<7) != 0 {
+		s.weekdays |= 1
+	}
+
+	s.anyDay = strings.HasPrefix(fields[2], "*")
+	s.anyWeekday = strings.HasPrefix(fields[4], "*")
+
+	return s, nil
+}
+
+func parseCronField(field string, lowest, highest int, names map[string]int) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rng, stepText, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepText); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q", stepText)
+			}
+		}
+
+		lo, hi := lowest, highest
+		if rng != "*" {
+			loText, hiText, isRange := strings.Cut(rng, "-")
+
+			var err error
+			if lo, err = parseCronValue(loText, lowest, highest, names); err != nil {
+				return 0, err
+			}
+
+			hi = lo
+			if isRange {
+				if hi, err = parseCronValue(hiText, lowest, highest, names); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				hi = highest
+			}
+
+			if hi < lo {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(text string, lowest, highest int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(text)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", text)
+	}
+
+	if v < lowest || v > highest {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, lowest, highest)
+	}
+
+	return v, nil
+}
+
+// matchesDate returns whether the schedule runs on the given date.
+func (s CronSchedule) matchesDate(date time.Time) bool {
+	if s.months&(1<<uint(date.Month())) == 0 {
+		return false
+	}
+
+	dayOK := s.days&(1<<uint(date.Day())) != 0
+	weekdayOK := s.weekdays&(1<<uint(date.Weekday())) != 0
+
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekdayOK
+	case s.anyWeekday:
+		return dayOK
+	default:
+		return dayOK || weekdayOK
+	}
+}
+
+// Periods returns the execution windows of the schedule that overlap with the
+// given period. Each window starts at an execution time and lasts for the
+// given duration. Execution times are evaluated on the wall clock of the
+// location of within.Start; wall-clock times that do not exist because of a
+// DST transition are skipped. Periods returns nil if within is not valid.
+func (s CronSchedule) Periods(d time.Duration, within Period) []Period {
+	if within.Validate() != nil {
+		return nil
+	}
+
+	loc := within.Start.Location()
+	from := within.Start.Add(-d)
+	if d > 0 {
+		from = from.Add(time.Nanosecond)
+	}
+
+	var out []Period
+	for date := StartOfDay(from.In(loc)); date.Before(within.End); date = StartOfDay(date.AddDate(0, 0, 1)) {
+		if !s.matchesDate(date) {
+			continue
+		}
+
+		y, m, dd := date.Date()
+		for h := 0; h < 24; h++ {
+			if s.hours&(1<<uint(h)) == 0 {
+				continue
+			}
+
+			for minute := 0; minute < 60; minute++ {
+				if s.minutes&(1<<uint(minute)) == 0 {
+					continue
+				}
+
+				t := time.Date(y, m, dd, h, minute, 0, 0, loc)
+				if t.Hour() != h || t.Minute() != minute {
+					continue
+				}
+
+				if t.Before(from) || !t.Before(within.End) {
+					continue
+				}
+
+				p := Period{Start: t, End: t.Add(d)}
+				if d == 0 || p.OverlapsWith(within) {
+					out = append(out, p)
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// CronPeriods parses the cron expression using [ParseCron] and returns the
+// execution windows of the given duration that overlap with the given period.
+// See [CronSchedule.Periods].
+func CronPeriods(expr string, d time.Duration, within Period) ([]Period, error) {
+	s, err := ParseCron(expr)
+	if err != nil {
+		return nil, err
+	}
+	return s.Periods(d, within), nil
+}
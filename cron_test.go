@@ -0,0 +1,155 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestCronPeriods(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	at := func(m time.Month, d, h, min int) time.Time {
+		return time.Date(2023, m, d, h, min, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		name   string
+		expr   string
+		d      time.Duration
+		within timefn.Period
+		want   []time.Time
+	}{
+		{
+			name:   "every 15 minutes",
+			expr:   "*/15 * * * *",
+			d:      5 * time.Minute,
+			within: timefn.Period{Start: at(time.January, 1, 10, 0), End: at(time.January, 1, 11, 0)},
+			want: []time.Time{
+				at(time.January, 1, 10, 0),
+				at(time.January, 1, 10, 15),
+				at(time.January, 1, 10, 30),
+				at(time.January, 1, 10, 45),
+			},
+		},
+		{
+			name:   "window reaching into period",
+			expr:   "0 * * * *",
+			d:      100 * time.Minute,
+			within: timefn.Period{Start: at(time.January, 1, 10, 30), End: at(time.January, 1, 12, 0)},
+			want: []time.Time{
+				at(time.January, 1, 9, 0),
+				at(time.January, 1, 10, 0),
+				at(time.January, 1, 11, 0),
+			},
+		},
+		{
+			name:   "weekdays at 9:30",
+			expr:   "30 9 * * MON-FRI",
+			d:      time.Hour,
+			within: timefn.Period{Start: at(time.March, 24, 0, 0), End: at(time.March, 28, 0, 0)},
+			want: []time.Time{
+				at(time.March, 24, 9, 30),
+				at(time.March, 27, 9, 30),
+			},
+		},
+		{
+			name:   "day of month or weekday",
+			expr:   "0 12 1 * SUN",
+			d:      time.Minute,
+			within: timefn.Period{Start: at(time.January, 1, 0, 0), End: at(time.January, 16, 0, 0)},
+			want: []time.Time{
+				at(time.January, 1, 12, 0),
+				at(time.January, 8, 12, 0),
+				at(time.January, 15, 12, 0),
+			},
+		},
+		{
+			name:   "stepped day of month is unrestricted",
+			expr:   "0 12 */2 * MON",
+			d:      time.Minute,
+			within: timefn.Period{Start: at(time.January, 1, 0, 0), End: at(time.January, 10, 0, 0)},
+			want: []time.Time{
+				at(time.January, 2, 12, 0),
+				at(time.January, 9, 12, 0),
+			},
+		},
+		{
+			name:   "stepped weekday is unrestricted",
+			expr:   "0 12 1 * */3",
+			d:      time.Minute,
+			within: timefn.Period{Start: at(time.January, 1, 0, 0), End: at(time.March, 1, 0, 0)},
+			want: []time.Time{
+				at(time.January, 1, 12, 0),
+				at(time.February, 1, 12, 0),
+			},
+		},
+		{
+			name:   "nonexistent time is skipped",
+			expr:   "30 2 * * *",
+			d:      time.Minute,
+			within: timefn.Period{Start: at(time.March, 25, 0, 0), End: at(time.March, 28, 0, 0)},
+			want: []time.Time{
+				at(time.March, 25, 2, 30),
+				at(time.March, 27, 2, 30),
+			},
+		},
+		{
+			name:   "macro",
+			expr:   "@monthly",
+			d:      time.Hour,
+			within: timefn.Period{Start: at(time.January, 15, 0, 0), End: at(time.April, 1, 0, 0)},
+			want: []time.Time{
+				at(time.February, 1, 0, 0),
+				at(time.March, 1, 0, 0),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timefn.CronPeriods(tt.expr, tt.d, tt.within)
+			if err != nil {
+				t.Fatalf("CronPeriods() failed: %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("CronPeriods() = %v, want starts %v", got, tt.want)
+			}
+
+			for i, p := range got {
+				if !p.Start.Equal(tt.want[i]) || p.Duration() != tt.d {
+					t.Errorf("window %d = %v, want start %v and duration %v", i, p, tt.want[i], tt.d)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCron_invalid(t *testing.T) {
+	exprs := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * FOO *",
+		"@every 5m",
+	}
+
+	for _, expr := range exprs {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := timefn.ParseCron(expr); err == nil {
+				t.Errorf("expected ParseCron(%q) to fail", expr)
+			}
+		})
+	}
+}
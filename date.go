@@ -0,0 +1,170 @@
+package timefn
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date is a calendar date without a time of day or location, such as a
+// holiday or a settlement date. It is comparable and can be used as a map key.
+// A Date is only meaningful if it is normalized, i.e. it names a date that
+// exists; use [DateOf] or [ParseDate] to create one.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the [Date] of the given time, as seen in the location of the
+// time.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// ParseDate parses a date in the form "2006-01-02".
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q", s)
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date in the form "2006-01-02".
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// MarshalText implements [encoding.TextMarshaler] using [Date.String].
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] using [ParseDate].
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// IsZero returns whether d is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// In returns the first instant of the date in loc, which is midnight unless
+// midnight does not exist because of a DST transition.
+func (d Date) In(loc *time.Location) time.Time {
+	return firstInstantOf(d.Year, d.Month, d.Day, loc)
+}
+
+// Period returns the period from the start of the date up to the start of the
+// next date in loc.
+func (d Date) Period(loc *time.Location) Period {
+	return Period{Start: d.In(loc), End: d.AddDays(1).In(loc)}
+}
+
+// AddDays returns the date that lies n days after d. A negative n returns an
+// earlier date.
+func (d Date) AddDays(n int) Date {
+	return DateOf(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+// Sub returns the number of days from o to d.
+func (d Date) Sub(o Date) int {
+	return int((d.utc().Unix() - o.utc().Unix()) / (24 * 60 * 60))
+}
+
+// Weekday returns the day of the week of the date.
+func (d Date) Weekday() time.Weekday {
+	return d.utc().Weekday()
+}
+
+// Compare returns -1 if d is before o, +1 if d is after o, and 0 if both are
+// the same date.
+func (d Date) Compare(o Date) int {
+	return d.utc().Compare(o.utc())
+}
+
+// Before returns whether d is before o.
+func (d Date) Before(o Date) bool {
+	return d.Compare(o) < 0
+}
+
+// After returns whether d is after o.
+func (d Date) After(o Date) bool {
+	return d.Compare(o) > 0
+}
+
+// utc returns midnight of the date in UTC.
+func (d Date) utc() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// addClamped adds the calendar components of dur to the date, or subtracts them
+// if sign is negative. Years and months are added first and clamp the day to
+// the last day of the resulting month; days are added after that.
+func (d Date) addClamped(dur CalendarDuration, sign int) Date {
+	month := Month{Year: d.Year, Month: d.Month}.Add(sign * (12*dur.Years + dur.Months))
+
+	day := d.Day
+	if days := month.Days(); day > days {
+		day = days
+	}
+
+	return Date{Year: month.Year, Month: month.Month, Day: day}.AddDays(sign * dur.Days)
+}
+
+// DatesSkipping returns the dates that overlap with p, as seen in the location
+// of p.Start, in chronological order. Dates for which skip returns true, such
+// as holidays, weekends or blackout dates, are omitted. If skip is nil, no
+// dates are omitted. DatesSkipping returns nil if p is not valid.
+func DatesSkipping(p Period, skip func(Date) bool) []Date {
+	if p.Validate() != nil || p.Duration() == 0 {
+		return nil
+	}
+
+	first := DateOf(p.Start)
+	last := DateOf(p.End.In(p.Start.Location()).Add(-time.Nanosecond))
+
+	var out []Date
+	for d := first; !d.After(last); d = d.AddDays(1) {
+		if skip == nil || !skip(d) {
+			out = append(out, d)
+		}
+	}
+
+	return out
+}
+
+// DatePeriod is a range of whole dates from Start up to, but not including,
+// End, such as an accrual period.
+type DatePeriod struct {
+	Start Date
+	End   Date
+}
+
+// String returns the period in the form "2006-01-02/2006-01-02".
+func (p DatePeriod) String() string {
+	return p.Start.String() + "/" + p.End.String()
+}
+
+// Days returns the number of days in the period.
+func (p DatePeriod) Days() int {
+	return p.End.Sub(p.Start)
+}
+
+// Contains returns whether d lies within the period.
+func (p DatePeriod) Contains(d Date) bool {
+	return !d.Before(p.Start) && d.Before(p.End)
+}
+
+// In returns the period from the start of the first date up to the start of
+// the end date in loc.
+func (p DatePeriod) In(loc *time.Location) Period {
+	return Period{Start: p.Start.In(loc), End: p.End.In(loc)}
+}
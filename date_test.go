@@ -0,0 +1,137 @@
+package timefn_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDate(t *testing.T) {
+	d, err := timefn.ParseDate("2024-02-29")
+	if err != nil {
+		t.Fatalf("ParseDate() failed: %v", err)
+	}
+	assert.Equal(t, timefn.Date{Year: 2024, Month: time.February, Day: 29}, d)
+	assert.Equal(t, "2024-02-29", d.String())
+
+	for _, s := range []string{"2023-02-29", "2024-2-1", "2024-02-01T00:00:00Z", ""} {
+		if _, err := timefn.ParseDate(s); err == nil {
+			t.Errorf("ParseDate(%q) should fail", s)
+		}
+	}
+}
+
+func TestDate_MarshalText(t *testing.T) {
+	d := timefn.Date{Year: 2024, Month: time.March, Day: 5}
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	assert.Equal(t, `"2024-03-05"`, string(b))
+
+	var got timefn.Date
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	assert.Equal(t, d, got)
+}
+
+func TestDate_Arithmetic(t *testing.T) {
+	d := timefn.Date{Year: 2024, Month: time.February, Day: 28}
+
+	assert.Equal(t, timefn.Date{Year: 2024, Month: time.March, Day: 1}, d.AddDays(2))
+	assert.Equal(t, timefn.Date{Year: 2023, Month: time.December, Day: 31}, d.AddDays(-59))
+	assert.Equal(t, 2, d.AddDays(2).Sub(d))
+	assert.Equal(t, time.Wednesday, d.Weekday())
+	assert.True(t, d.Before(d.AddDays(1)))
+	assert.True(t, d.After(d.AddDays(-1)))
+	assert.Equal(t, 0, d.Compare(d))
+
+	// More days than fit into a time.Duration.
+	first, last := timefn.Date{Year: 1, Month: time.January, Day: 1}, timefn.Date{Year: 2024, Month: time.January, Day: 1}
+	assert.Equal(t, -738885, first.Sub(last))
+	assert.Equal(t, 738885, last.Sub(first))
+	assert.Equal(t, 738885, timefn.DatePeriod{Start: first, End: last}.Days())
+}
+
+func TestDate_In(t *testing.T) {
+	// Havana switches to DST at midnight, so March 12, 2023 starts at 01:00.
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	d := timefn.Date{Year: 2023, Month: time.March, Day: 12}
+	assert.True(t, time.Date(2023, time.March, 12, 1, 0, 0, 0, havana).Equal(d.In(havana)))
+
+	p := d.Period(havana)
+	assert.Equal(t, 23*time.Hour, p.Duration())
+}
+
+func TestDatesSkipping(t *testing.T) {
+	weekend := func(d timefn.Date) bool {
+		return d.Weekday() == time.Saturday || d.Weekday() == time.Sunday
+	}
+
+	tests := []struct {
+		name string
+		p    timefn.Period
+		skip func(timefn.Date) bool
+		want []string
+	}{
+		{
+			name: "skip weekends",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 9, 12, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 14, 0, 0, 0, 0, time.UTC),
+			},
+			skip: weekend,
+			want: []string{"2023-03-09", "2023-03-10", "2023-03-13"},
+		},
+		{
+			name: "without skip",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 9, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 10, 0, 0, 1, 0, time.UTC),
+			},
+			want: []string{"2023-03-09", "2023-03-10"},
+		},
+		{
+			name: "invalid period",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 10, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 9, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, d := range timefn.DatesSkipping(tt.p, tt.skip) {
+				got = append(got, d.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDatePeriod(t *testing.T) {
+	p := timefn.DatePeriod{
+		Start: timefn.Date{Year: 2024, Month: time.February, Day: 28},
+		End:   timefn.Date{Year: 2024, Month: time.March, Day: 2},
+	}
+
+	assert.Equal(t, "2024-02-28/2024-03-02", p.String())
+	assert.Equal(t, 3, p.Days())
+	assert.True(t, p.Contains(timefn.Date{Year: 2024, Month: time.February, Day: 29}))
+	assert.False(t, p.Contains(p.End))
+	assert.Equal(t, timefn.Period{
+		Start: time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+	}, p.In(time.UTC))
+}
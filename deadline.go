@@ -0,0 +1,59 @@
+package timefn
+
+import "time"
+
+// DeadlineClass classifies a due date relative to the current time. See
+// [ClassifyDeadline].
+type DeadlineClass int
+
+const (
+	// Overdue is the class of deadlines that lie before the current time.
+	Overdue DeadlineClass = iota
+
+	// DueToday is the class of deadlines that lie between the current time and
+	// the end of the current day.
+	DueToday
+
+	// DueThisWeek is the class of deadlines that lie after the current day but
+	// before the end of the current week.
+	DueThisWeek
+
+	// Later is the class of deadlines that lie after the current week.
+	Later
+)
+
+// String returns the name of the deadline class.
+func (c DeadlineClass) String() string {
+	switch c {
+	case Overdue:
+		return "overdue"
+	case DueToday:
+		return "due today"
+	case DueThisWeek:
+		return "due this week"
+	case Later:
+		return "later"
+	default:
+		return "<unknown deadline class>"
+	}
+}
+
+// ClassifyDeadline classifies the due time relative to now into one of
+// [Overdue], [DueToday], [DueThisWeek] or [Later]. Day and week boundaries are
+// computed in the location of now, as defined by [EndOfDay] and [EndOfWeek], so
+// the same due time may be classified differently for users in different time
+// zones. A deadline that is due exactly now is not yet overdue.
+func ClassifyDeadline(due, now time.Time) DeadlineClass {
+	due = due.In(now.Location())
+
+	switch {
+	case due.Before(now):
+		return Overdue
+	case SameOrBefore(due, EndOfDay(now)):
+		return DueToday
+	case SameOrBefore(due, EndOfWeek(now)):
+		return DueThisWeek
+	default:
+		return Later
+	}
+}
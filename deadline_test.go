@@ -0,0 +1,44 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestClassifyDeadline(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	// Wednesday
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, berlin)
+
+	tests := []struct {
+		name string
+		due  time.Time
+		want timefn.DeadlineClass
+	}{
+		{name: "in the past", due: now.Add(-time.Nanosecond), want: timefn.Overdue},
+		{name: "now", due: now, want: timefn.DueToday},
+		{name: "end of day", due: timefn.EndOfDay(now), want: timefn.DueToday},
+		{name: "tomorrow", due: now.AddDate(0, 0, 1), want: timefn.DueThisWeek},
+		{name: "end of week", due: timefn.EndOfWeek(now), want: timefn.DueThisWeek},
+		{name: "next week", due: timefn.EndOfWeek(now).Add(time.Nanosecond), want: timefn.Later},
+		{
+			name: "UTC time on the next day in Berlin",
+			due:  time.Date(2023, time.March, 15, 23, 30, 0, 0, time.UTC),
+			want: timefn.DueThisWeek,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.ClassifyDeadline(tt.due, now); got != tt.want {
+				t.Errorf("ClassifyDeadline(%v, %v) = %v, want %v", tt.due, now, got, tt.want)
+			}
+		})
+	}
+}
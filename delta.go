@@ -0,0 +1,36 @@
+package timefn
+
+// Delta describes the changes between two snapshots of a [PeriodSet]. Applying
+// it to the old snapshot using [ApplyDelta] yields the new one. Use
+// [EncodeDelta] to create one.
+type Delta struct {
+	// Added are the periods that are contained in the new snapshot but not in
+	// the old one.
+	Added []Period `json:"added,omitempty"`
+
+	// Removed are the periods that are contained in the old snapshot but not
+	// in the new one.
+	Removed []Period `json:"removed,omitempty"`
+}
+
+// IsEmpty returns whether the delta does not contain any changes.
+func (d Delta) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0
+}
+
+// EncodeDelta returns the [Delta] that turns the set from into the set to.
+// The delta only contains the instants that changed, so extending a period by
+// an hour results in a single added period of one hour.
+func EncodeDelta(from, to PeriodSet) Delta {
+	return Delta{
+		Added:   to.Difference(from).Periods(),
+		Removed: from.Difference(to).Periods(),
+	}
+}
+
+// ApplyDelta applies the delta to the set and returns the resulting set. The
+// removed periods are subtracted before the added periods are added. Applying
+// the delta returned by [EncodeDelta] to its first set yields its second set.
+func ApplyDelta(set PeriodSet, d Delta) PeriodSet {
+	return set.Difference(NewPeriodSet(d.Removed...)).Union(NewPeriodSet(d.Added...))
+}
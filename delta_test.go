@@ -0,0 +1,86 @@
+package timefn_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestEncodeDelta(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC)
+	}
+
+	old := timefn.NewPeriodSet(
+		timefn.Period{Start: at(8), End: at(10)},
+		timefn.Period{Start: at(12), End: at(13)},
+		timefn.Period{Start: at(15), End: at(16)},
+	)
+
+	updated := timefn.NewPeriodSet(
+		timefn.Period{Start: at(8), End: at(11)},
+		timefn.Period{Start: at(15), End: at(16)},
+		timefn.Period{Start: at(18), End: at(19)},
+	)
+
+	delta := timefn.EncodeDelta(old, updated)
+
+	wantAdded := []timefn.Period{
+		{Start: at(10), End: at(11)},
+		{Start: at(18), End: at(19)},
+	}
+	wantRemoved := []timefn.Period{
+		{Start: at(12), End: at(13)},
+	}
+
+	if !timefn.NewPeriodSet(delta.Added...).Equal(timefn.NewPeriodSet(wantAdded...)) {
+		t.Errorf("Added = %v, want %v", delta.Added, wantAdded)
+	}
+
+	if !timefn.NewPeriodSet(delta.Removed...).Equal(timefn.NewPeriodSet(wantRemoved...)) {
+		t.Errorf("Removed = %v, want %v", delta.Removed, wantRemoved)
+	}
+
+	if got := timefn.ApplyDelta(old, delta); !got.Equal(updated) {
+		t.Errorf("ApplyDelta() = %v, want %v", got, updated)
+	}
+
+	if !timefn.EncodeDelta(updated, updated).IsEmpty() {
+		t.Errorf("expected empty delta between equal sets")
+	}
+}
+
+func TestApplyDelta_random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	randomSet := func() timefn.PeriodSet {
+		periods := make([]timefn.Period, r.Intn(10))
+		for i := range periods {
+			s := start.Add(time.Duration(r.Intn(100)) * time.Hour)
+			periods[i] = timefn.Period{Start: s, End: s.Add(time.Duration(r.Intn(10)+1) * time.Hour)}
+		}
+		return timefn.NewPeriodSet(periods...)
+	}
+
+	for i := 0; i < 200; i++ {
+		old, updated := randomSet(), randomSet()
+
+		data, err := json.Marshal(timefn.EncodeDelta(old, updated))
+		if err != nil {
+			t.Fatalf("marshal delta: %v", err)
+		}
+
+		var delta timefn.Delta
+		if err := json.Unmarshal(data, &delta); err != nil {
+			t.Fatalf("unmarshal delta: %v", err)
+		}
+
+		if got := timefn.ApplyDelta(old, delta); !got.Equal(updated) {
+			t.Fatalf("ApplyDelta(%v, %v) = %v, want %v", old, delta, got, updated)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package timefn
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// durationUnits are the units used by [FormatDuration], from largest to
+// smallest.
+var durationUnits = [...]struct {
+	size  time.Duration
+	names func(*Locale) UnitNames
+}{
+	{24 * time.Hour, func(l *Locale) UnitNames { return l.Days }},
+	{time.Hour, func(l *Locale) UnitNames { return l.Hours }},
+	{time.Minute, func(l *Locale) UnitNames { return l.Minutes }},
+	{time.Second, func(l *Locale) UnitNames { return l.Seconds }},
+	{time.Millisecond, func(l *Locale) UnitNames { return l.Milliseconds }},
+}
+
+// FormatDurationOption is an option for [FormatDuration].
+type FormatDurationOption func(*formatDurationConfig)
+
+type formatDurationConfig struct {
+	maxUnits int
+	smallest time.Duration
+	round    bool
+	locale   *Locale
+}
+
+// WithMaxUnits returns a [FormatDurationOption] that limits the output of
+// [FormatDuration] to the n largest units, starting at the largest non-zero
+// unit, so that 2 days, 3 hours and 5 minutes are formatted as "2 days 3
+// hours" with a limit of 2. A limit of zero or less means no limit.
+func WithMaxUnits(n int) FormatDurationOption {
+	return func(cfg *formatDurationConfig) {
+		cfg.maxUnits = n
+	}
+}
+
+// WithSmallestUnit returns a [FormatDurationOption] that sets the smallest unit
+// that [FormatDuration] outputs, replacing the default of a second. The unit
+// is rounded down to one of a day, hour, minute, second or millisecond.
+func WithSmallestUnit(unit time.Duration) FormatDurationOption {
+	return func(cfg *formatDurationConfig) {
+		cfg.smallest = unit
+	}
+}
+
+// WithRounding returns a [FormatDurationOption] that makes [FormatDuration]
+// round the duration to the smallest output unit instead of truncating it, so
+// that 1 hour and 59 minutes are formatted as "2 hours" with a limit of one
+// unit.
+func WithRounding() FormatDurationOption {
+	return func(cfg *formatDurationConfig) {
+		cfg.round = true
+	}
+}
+
+// WithLocale returns a [FormatDurationOption] that makes [FormatDuration] use
+// the unit names of the given locale instead of English ones.
+func WithLocale(l *Locale) FormatDurationOption {
+	return func(cfg *formatDurationConfig) {
+		cfg.locale = l
+	}
+}
+
+// FormatDuration formats d as a human-readable duration in English, such as
+// "2 days 3 hours 5 minutes", or in the language given by [WithLocale]. Days are always 24 hours long. Units that are
+// zero are omitted, and parts of the duration that are smaller than the
+// smallest output unit are truncated, unless [WithRounding] is given. Negative
+// durations are prefixed with "-". A duration that is zero in the output units
+// is formatted as "0" followed by the smallest output unit, e.g. "0 seconds".
+func FormatDuration(d time.Duration, opts ...FormatDurationOption) string {
+	cfg := formatDurationConfig{smallest: time.Second}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	locale := cfg.locale.orEnglish()
+
+	var sign string
+	if d < 0 {
+		sign = "-"
+		if d == math.MinInt64 {
+			d = math.MaxInt64
+		} else {
+			d = -d
+		}
+	}
+
+	smallest := len(durationUnits) - 1
+	for i, u := range durationUnits {
+		if u.size <= cfg.smallest {
+			smallest = i
+			break
+		}
+	}
+
+	first, lowest := durationUnitRange(d, smallest, cfg.maxUnits)
+	if cfg.round {
+		// Rounding can carry over into a larger unit, which moves the range.
+		for {
+			d = d.Round(durationUnits[lowest].size)
+			nextFirst, nextLowest := durationUnitRange(d, smallest, cfg.maxUnits)
+			if nextFirst == first && nextLowest == lowest {
+				break
+			}
+			first, lowest = nextFirst, nextLowest
+		}
+	} else {
+		d = d.Truncate(durationUnits[lowest].size)
+	}
+
+	var parts []string
+	for _, u := range durationUnits[first : lowest+1] {
+		n := d / u.size
+		d -= n * u.size
+		if n > 0 {
+			parts = append(parts, formatDurationUnit(int64(n), u.names(locale)))
+		}
+	}
+
+	if len(parts) == 0 {
+		return formatDurationUnit(0, durationUnits[lowest].names(locale))
+	}
+
+	return sign + strings.Join(parts, " ")
+}
+
+// durationUnitRange returns the indexes of the largest and smallest units that
+// are used to format d.
+func durationUnitRange(d time.Duration, smallest, maxUnits int) (first, lowest int) {
+	first = smallest
+	for i, u := range durationUnits[:smallest+1] {
+		if d >= u.size {
+			first = i
+			break
+		}
+	}
+
+	lowest = smallest
+	if maxUnits > 0 && first+maxUnits-1 < lowest {
+		lowest = first + maxUnits - 1
+	}
+
+	return first, lowest
+}
+
+func formatDurationUnit(n int64, names UnitNames) string {
+	if n == 1 {
+		return "1 " + names.One
+	}
+	return fmt.Sprintf("%d %s", n, names.Other)
+}
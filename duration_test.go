@@ -0,0 +1,98 @@
+package timefn_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDuration(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name string
+		d    time.Duration
+		opts []timefn.FormatDurationOption
+		want string
+	}{
+		{name: "zero", d: 0, want: "0 seconds"},
+		{name: "singular", d: day + time.Hour + time.Minute + time.Second, want: "1 day 1 hour 1 minute 1 second"},
+		{name: "plural", d: 2*day + 3*time.Hour + 5*time.Minute, want: "2 days 3 hours 5 minutes"},
+		{name: "zero units omitted", d: 2*day + 5*time.Minute, want: "2 days 5 minutes"},
+		{name: "sub-second truncated", d: 1500 * time.Millisecond, want: "1 second"},
+		{name: "below smallest unit", d: 300 * time.Millisecond, want: "0 seconds"},
+		{name: "negative", d: -90 * time.Minute, want: "-1 hour 30 minutes"},
+		{
+			name: "max units",
+			d:    2*day + 3*time.Hour + 5*time.Minute,
+			opts: []timefn.FormatDurationOption{timefn.WithMaxUnits(2)},
+			want: "2 days 3 hours",
+		},
+		{
+			name: "max units counts skipped units",
+			d:    2*day + 5*time.Minute,
+			opts: []timefn.FormatDurationOption{timefn.WithMaxUnits(2)},
+			want: "2 days",
+		},
+		{
+			name: "smallest unit",
+			d:    3*time.Hour + 5*time.Minute + 30*time.Second,
+			opts: []timefn.FormatDurationOption{timefn.WithSmallestUnit(time.Minute)},
+			want: "3 hours 5 minutes",
+		},
+		{
+			name: "smallest unit zero",
+			d:    30 * time.Minute,
+			opts: []timefn.FormatDurationOption{timefn.WithSmallestUnit(time.Hour)},
+			want: "0 hours",
+		},
+		{
+			name: "milliseconds",
+			d:    1500 * time.Millisecond,
+			opts: []timefn.FormatDurationOption{timefn.WithSmallestUnit(time.Millisecond)},
+			want: "1 second 500 milliseconds",
+		},
+		{
+			name: "rounding",
+			d:    time.Hour + 59*time.Minute,
+			opts: []timefn.FormatDurationOption{timefn.WithMaxUnits(1), timefn.WithRounding()},
+			want: "2 hours",
+		},
+		{
+			name: "rounding carries into larger unit",
+			d:    23*time.Hour + 59*time.Minute + 45*time.Second,
+			opts: []timefn.FormatDurationOption{timefn.WithMaxUnits(2), timefn.WithRounding()},
+			want: "1 day",
+		},
+		{
+			name: "truncation",
+			d:    time.Hour + 59*time.Minute,
+			opts: []timefn.FormatDurationOption{timefn.WithMaxUnits(1)},
+			want: "1 hour",
+		},
+		{name: "minimum", d: math.MinInt64, opts: []timefn.FormatDurationOption{timefn.WithMaxUnits(1)}, want: "-106751 days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, timefn.FormatDuration(tt.d, tt.opts...))
+		})
+	}
+}
+
+func TestPeriod_FormatAs_duration(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2023, time.March, 1, 9, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.March, 2, 10, 30, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, "1 day 1 hour 30 minutes", p.FormatAs("{{ duration . }}"))
+	assert.Equal(t, "inf", timefn.Period{Start: p.Start}.FormatAs("{{ duration . }}"))
+
+	// .Duration is the time.Duration of the period, as before.
+	assert.Equal(t, "25h30m0s", p.FormatAs("{{ .Duration }}"))
+	assert.Equal(t, "25.5", p.FormatAs("{{ printf \"%.1f\" .Duration.Hours }}"))
+}
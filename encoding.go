@@ -0,0 +1,194 @@
+package timefn
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// openBoundary is the ISO 8601 notation for an open interval boundary.
+const openBoundary = ".."
+
+// MarshalText implements [encoding.TextMarshaler]. The period is encoded as an
+// ISO 8601 time interval of the form "start/end", where both boundaries are
+// formatted using [time.RFC3339Nano], e.g.
+// "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z". Open boundaries are encoded as
+// "..", and the empty period is encoded as an empty string.
+func (p Period) MarshalText() ([]byte, error) {
+	if p.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(formatBoundary(p.Start) + "/" + formatBoundary(p.End)), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. It decodes an ISO 8601
+// time interval as produced by [Period.MarshalText], and additionally accepts
+// all forms supported by [ParsePeriod]. An empty text decodes into the empty
+// period.
+func (p *Period) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = Period{}
+		return nil
+	}
+
+	parsed, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+
+	return nil
+}
+
+// jsonPeriod has the same JSON representation as [Period], but does not
+// implement [encoding.TextMarshaler]. Without it, periods would be encoded as
+// JSON strings.
+type jsonPeriod struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// MarshalJSON implements [json.Marshaler]. Periods are encoded as JSON objects
+// with a "start" and "end" field. Only map keys use the text encoding of
+// [Period.MarshalText].
+func (p Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonPeriod(p))
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It decodes periods from JSON
+// objects with a "start" and "end" field. Like [json.Unmarshal], it leaves the
+// period unchanged if data is the JSON null value.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var jp jsonPeriod
+	if err := json.Unmarshal(data, &jp); err != nil {
+		return err
+	}
+	*p = Period(jp)
+	return nil
+}
+
+func formatBoundary(t time.Time) string {
+	if t.IsZero() {
+		return openBoundary
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func parseBoundary(s string) (time.Time, error) {
+	if s == "" || s == openBoundary {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+// StrictPeriod is a [Period] that is validated when it is decoded. Use it in
+// place of [Period] in request payloads and configuration structs to reject
+// periods whose start or end is missing, or whose end is not after the start.
+// Decoding returns the same errors as [Period.Validate]. Encoding is identical
+// to that of [Period].
+type StrictPeriod struct {
+	Period
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It decodes the period like
+// [Period.UnmarshalJSON] and validates the result using [Period.Validate].
+func (p *StrictPeriod) UnmarshalJSON(data []byte) error {
+	var decoded Period
+	if err := decoded.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	if err := decoded.Validate(); err != nil {
+		return err
+	}
+
+	p.Period = decoded
+
+	return nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. It decodes the period
+// like [Period.UnmarshalText] and validates the result using
+// [Period.Validate].
+func (p *StrictPeriod) UnmarshalText(text []byte) error {
+	var decoded Period
+	if err := decoded.UnmarshalText(text); err != nil {
+		return err
+	}
+
+	if err := decoded.Validate(); err != nil {
+		return err
+	}
+
+	p.Period = decoded
+
+	return nil
+}
+
+// MarshalZoned encodes t together with the IANA name of its location, in the
+// form "2006-01-02T15:04:05.999999999+01:00[Europe/Berlin]" defined by
+// RFC 9557. Unlike an offset alone, the zone name allows [UnmarshalZoned] to
+// restore the location, so that DST rules apply correctly to the restored
+// time, e.g. when it is moved by a day. Times in a location without a name
+// are encoded without the zone suffix. MarshalZoned returns an error for times
+// in [time.Local], whose name does not identify a zone.
+func MarshalZoned(t time.Time) ([]byte, error) {
+	name := t.Location().String()
+	if t.Location() == time.Local {
+		return nil, fmt.Errorf("marshal zoned time: location %q has no IANA zone name", name)
+	}
+
+	out := t.Format(time.RFC3339Nano)
+	if name != "" {
+		out += "[" + name + "]"
+	}
+
+	return []byte(out), nil
+}
+
+// UnmarshalZoned decodes a time encoded by [MarshalZoned] and attaches its
+// location, which is loaded using [time.LoadLocation]. Data without a zone
+// suffix is decoded like [time.RFC3339Nano]. If the encoded offset no longer
+// matches the rules of the zone, because the rules have changed since the time
+// was encoded, the encoded wall-clock time is kept and resolved using the
+// current rules. This keeps stored future events, like a meeting at 09:00, at
+// their intended local time.
+func UnmarshalZoned(data []byte) (time.Time, error) {
+	s := string(data)
+
+	value, zone, hasZone := strings.Cut(s, "[")
+	if !hasZone {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unmarshal zoned time %q: %w", s, err)
+		}
+		return t, nil
+	}
+
+	zone, ok := strings.CutSuffix(zone, "]")
+	if !ok || zone == "" {
+		return time.Time{}, fmt.Errorf("unmarshal zoned time %q: invalid zone suffix", s)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unmarshal zoned time %q: %w", s, err)
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unmarshal zoned time %q: %w", s, err)
+	}
+
+	_, encoded := t.Zone()
+	if _, current := t.In(loc).Zone(); current == encoded {
+		return t.In(loc), nil
+	}
+
+	return resolveWallClock(wallClock(t), loc, wallClockConfig{})
+}
@@ -0,0 +1,280 @@
+package timefn_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestPeriod_MarshalText(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	feb1 := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		want   string
+	}{
+		{
+			name:   "closed",
+			period: timefn.Period{Start: jan1, End: feb1},
+			want:   "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z",
+		},
+		{
+			name:   "nanoseconds and offset",
+			period: timefn.Period{Start: jan1.Add(time.Nanosecond).In(time.FixedZone("", 3600)), End: feb1},
+			want:   "2023-01-01T01:00:00.000000001+01:00/2023-02-01T00:00:00Z",
+		},
+		{
+			name:   "open end",
+			period: timefn.Period{Start: jan1},
+			want:   "2023-01-01T00:00:00Z/..",
+		},
+		{
+			name:   "empty",
+			period: timefn.Period{},
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.period.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() failed: %v", err)
+			}
+
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() = %q, want %q", text, tt.want)
+			}
+
+			var got timefn.Period
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText(%q) failed: %v", text, err)
+			}
+
+			if !got.Start.Equal(tt.period.Start) || !got.End.Equal(tt.period.End) {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", text, got, tt.period)
+			}
+		})
+	}
+}
+
+func TestPeriod_UnmarshalText_invalid(t *testing.T) {
+	for _, text := range []string{
+		"2023-01-01T00:00:00Z",
+		"2023-01-01/2023-02-01",
+		"2023-01-01T00:00:00Z/foo",
+	} {
+		var p timefn.Period
+		if err := p.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("UnmarshalText(%q) should fail", text)
+		}
+	}
+}
+
+func TestPeriod_JSON(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	b, err := json.Marshal(map[timefn.Period]timefn.Period{p: p})
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %v", err)
+	}
+
+	want := `{"2023-01-01T00:00:00Z/2023-02-01T00:00:00Z":{"start":"2023-01-01T00:00:00Z","end":"2023-02-01T00:00:00Z"}}`
+	if string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	var got map[timefn.Period]timefn.Period
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+
+	if got[p] != p {
+		t.Errorf("json.Unmarshal() = %v, want %v", got, map[timefn.Period]timefn.Period{p: p})
+	}
+}
+
+func TestPeriod_UnmarshalJSON_null(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := p
+	if err := json.Unmarshal([]byte("null"), &got); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	if got != p {
+		t.Errorf("json.Unmarshal(null) = %v, want %v", got, p)
+	}
+
+	var s struct {
+		Period timefn.Period `json:"period"`
+	}
+	s.Period = p
+	if err := json.Unmarshal([]byte(`{"period":null}`), &s); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	if s.Period != p {
+		t.Errorf("json.Unmarshal(null) = %v, want %v", s.Period, p)
+	}
+}
+
+func TestStrictPeriod_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid", input: `{"start":"2023-01-01T00:00:00Z","end":"2023-02-01T00:00:00Z"}`},
+		{name: "missing start", input: `{"end":"2023-02-01T00:00:00Z"}`, wantErr: true},
+		{name: "missing end", input: `{"start":"2023-01-01T00:00:00Z"}`, wantErr: true},
+		{name: "null end", input: `{"start":"2023-01-01T00:00:00Z","end":null}`, wantErr: true},
+		{name: "end equals start", input: `{"start":"2023-01-01T00:00:00Z","end":"2023-01-01T00:00:00Z"}`, wantErr: true},
+		{name: "inverted", input: `{"start":"2023-02-01T00:00:00Z","end":"2023-01-01T00:00:00Z"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p timefn.StrictPeriod
+			err := json.Unmarshal([]byte(tt.input), &p)
+
+			if tt.wantErr && err == nil {
+				t.Errorf("json.Unmarshal(%s) should fail; got %v", tt.input, p)
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestStrictPeriod_MarshalJSON(t *testing.T) {
+	p := timefn.StrictPeriod{Period: timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %v", err)
+	}
+
+	want := `{"start":"2023-01-01T00:00:00Z","end":"2023-02-01T00:00:00Z"}`
+	if string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+}
+
+func TestStrictPeriod_UnmarshalText(t *testing.T) {
+	var p timefn.StrictPeriod
+
+	if err := p.UnmarshalText([]byte("2023-01-01T00:00:00Z/P1M")); err != nil {
+		t.Errorf("UnmarshalText() failed: %v", err)
+	}
+
+	if err := p.UnmarshalText([]byte("2023-01-01T00:00:00Z/..")); err == nil {
+		t.Errorf("UnmarshalText() should fail for open periods")
+	}
+}
+
+func TestMarshalZoned(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{
+			name: "iana zone",
+			t:    time.Date(2030, time.July, 1, 9, 0, 0, 0, berlin),
+			want: "2030-07-01T09:00:00+02:00[Europe/Berlin]",
+		},
+		{
+			name: "utc",
+			t:    time.Date(2030, time.July, 1, 9, 0, 0, 500, time.UTC),
+			want: "2030-07-01T09:00:00.0000005Z[UTC]",
+		},
+		{
+			name: "unnamed fixed zone",
+			t:    time.Date(2030, time.July, 1, 9, 0, 0, 0, time.FixedZone("", 3600)),
+			want: "2030-07-01T09:00:00+01:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := timefn.MarshalZoned(tt.t)
+			if err != nil {
+				t.Fatalf("MarshalZoned() failed: %v", err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("MarshalZoned() = %s; want %s", b, tt.want)
+			}
+
+			got, err := timefn.UnmarshalZoned(b)
+			if err != nil {
+				t.Fatalf("UnmarshalZoned() failed: %v", err)
+			}
+			if !got.Equal(tt.t) {
+				t.Errorf("UnmarshalZoned() = %v; want %v", got, tt.t)
+			}
+			if name := tt.t.Location().String(); name != "" && got.Location().String() != name {
+				t.Errorf("UnmarshalZoned() location = %s; want %s", got.Location(), name)
+			}
+		})
+	}
+
+	if _, err := timefn.MarshalZoned(time.Now()); err == nil {
+		t.Errorf("MarshalZoned() of a local time should fail")
+	}
+}
+
+func TestUnmarshalZoned(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	// Encoded with an offset that does not match the current rules, as if the
+	// zone had abolished DST after the time was stored.
+	got, err := timefn.UnmarshalZoned([]byte("2030-07-01T09:00:00+01:00[Europe/Berlin]"))
+	if err != nil {
+		t.Fatalf("UnmarshalZoned() failed: %v", err)
+	}
+	if want := time.Date(2030, time.July, 1, 9, 0, 0, 0, berlin); !got.Equal(want) || got.Location().String() != berlin.String() {
+		t.Errorf("UnmarshalZoned() = %v; want %v", got, want)
+	}
+
+	// The restored location applies DST rules to later calculations.
+	got, err = timefn.UnmarshalZoned([]byte("2030-03-30T09:00:00+01:00[Europe/Berlin]"))
+	if err != nil {
+		t.Fatalf("UnmarshalZoned() failed: %v", err)
+	}
+	if want := time.Date(2030, time.April, 1, 9, 0, 0, 0, berlin); !got.AddDate(0, 0, 2).Equal(want) {
+		t.Errorf("restored time moved by two days = %v; want %v", got.AddDate(0, 0, 2), want)
+	}
+
+	for _, input := range []string{
+		"",
+		"2030-07-01T09:00:00",
+		"2030-07-01T09:00:00+02:00[Europe/Berlin",
+		"2030-07-01T09:00:00+02:00[]",
+		"2030-07-01T09:00:00+02:00[Nowhere/Nothing]",
+		"invalid[Europe/Berlin]",
+	} {
+		if _, err := timefn.UnmarshalZoned([]byte(input)); err == nil {
+			t.Errorf("UnmarshalZoned(%q) should fail", input)
+		}
+	}
+}
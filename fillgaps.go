@@ -0,0 +1,57 @@
+package timefn
+
+import "slices"
+
+// FillGaps returns the periods clipped to bounds, with explicit periods
+// inserted for the gaps between them, so that the result tiles bounds exactly:
+// it is sorted, its first period starts at the start of bounds, each period
+// starts at the end of the previous one, and its last period ends at the end
+// of bounds. Overlapping and adjacent periods are merged first, like by
+// [Merge]. Use [FillGapsLabeled] to tell the gaps apart from the given
+// periods. FillGaps returns nil if bounds is not valid. The input slice is not
+// modified.
+func FillGaps(periods []Period, bounds Period) []Period {
+	if bounds.Validate() != nil {
+		return nil
+	}
+
+	covered := Intersect(periods, []Period{bounds})
+	gaps := Subtract([]Period{bounds}, covered)
+
+	out := append(covered, gaps...)
+	SortPeriods(out)
+
+	return out
+}
+
+// FillGapsLabeled returns the periods clipped to bounds, with periods that are
+// labeled with gapLabel inserted for the parts of bounds that no period
+// covers. Unlike [FillGaps], the periods are not merged, so that they keep
+// their labels; the result tiles bounds exactly if the periods do not overlap.
+// The result is sorted by [ComparePeriods], and periods that lie outside of
+// bounds are dropped. FillGapsLabeled returns nil if bounds is not valid. The
+// input slice is not modified.
+func FillGapsLabeled(periods []LabeledPeriod, bounds Period, gapLabel string) []LabeledPeriod {
+	if bounds.Validate() != nil {
+		return nil
+	}
+
+	out := make([]LabeledPeriod, 0, len(periods))
+	covered := make([]Period, 0, len(periods))
+	for _, lp := range periods {
+		if p, ok := lp.Period.intersection(bounds); ok {
+			out = append(out, LabeledPeriod{Period: p, Label: lp.Label})
+			covered = append(covered, p)
+		}
+	}
+
+	for _, gap := range Subtract([]Period{bounds}, covered) {
+		out = append(out, LabeledPeriod{Period: gap, Label: gapLabel})
+	}
+
+	slices.SortStableFunc(out, func(a, b LabeledPeriod) int {
+		return ComparePeriods(a.Period, b.Period)
+	})
+
+	return out
+}
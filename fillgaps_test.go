@@ -0,0 +1,100 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestFillGaps(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: at(start), End: at(end)} }
+
+	tests := []struct {
+		name    string
+		periods []timefn.Period
+		bounds  timefn.Period
+		want    []timefn.Period
+	}{
+		{
+			name:   "no periods",
+			bounds: p(0, 10),
+			want:   []timefn.Period{p(0, 10)},
+		},
+		{
+			name:    "gaps between and around",
+			periods: []timefn.Period{p(5, 6), p(2, 3)},
+			bounds:  p(0, 10),
+			want:    []timefn.Period{p(0, 2), p(2, 3), p(3, 5), p(5, 6), p(6, 10)},
+		},
+		{
+			name:    "clipped and merged",
+			periods: []timefn.Period{p(0, 3), p(2, 5), p(8, 12)},
+			bounds:  p(1, 10),
+			want:    []timefn.Period{p(1, 5), p(5, 8), p(8, 10)},
+		},
+		{
+			name:    "fully covered",
+			periods: []timefn.Period{{Start: at(1)}},
+			bounds:  p(2, 4),
+			want:    []timefn.Period{p(2, 4)},
+		},
+		{
+			name:    "invalid bounds",
+			periods: []timefn.Period{p(1, 2)},
+			bounds:  p(4, 2),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timefn.FillGaps(tt.periods, tt.bounds)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FillGaps() = %v; want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || !got[i].End.Equal(tt.want[i].End) {
+					t.Errorf("FillGaps() = %v; want %v", got, tt.want)
+					break
+				}
+			}
+			if len(got) > 0 && !timefn.IsContiguous(got) {
+				t.Errorf("FillGaps() = %v is not contiguous", got)
+			}
+		})
+	}
+}
+
+func TestFillGapsLabeled(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: at(start), End: at(end)} }
+
+	got := timefn.FillGapsLabeled([]timefn.LabeledPeriod{
+		{Period: p(3, 5), Label: "b"},
+		{Period: p(0, 2), Label: "a"},
+		{Period: p(5, 7), Label: "c"},
+		{Period: p(9, 11), Label: "d"},
+	}, p(1, 8), "unknown")
+
+	want := []timefn.LabeledPeriod{
+		{Period: p(1, 2), Label: "a"},
+		{Period: p(2, 3), Label: "unknown"},
+		{Period: p(3, 5), Label: "b"},
+		{Period: p(5, 7), Label: "c"},
+		{Period: p(7, 8), Label: "unknown"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("FillGapsLabeled() = %v; want %v", got, want)
+	}
+	for i := range got {
+		if got[i].Label != want[i].Label || !got[i].Period.Start.Equal(want[i].Period.Start) || !got[i].Period.End.Equal(want[i].Period.End) {
+			t.Errorf("FillGapsLabeled()[%d] = %v; want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := timefn.FillGapsLabeled(nil, timefn.Period{}, "gap"); got != nil {
+		t.Errorf("FillGapsLabeled() with invalid bounds = %v; want nil", got)
+	}
+}
@@ -0,0 +1,125 @@
+package timefn
+
+import (
+	"fmt"
+	"time"
+)
+
+// floatingLayout is the text format of a [FloatingTime].
+const floatingLayout = "2006-01-02T15:04:05.999999999"
+
+// FloatingTime is a wall-clock date and time that is not bound to a location,
+// such as "9am local time, wherever the user is". Unlike a [time.Time], it does
+// not identify an instant by itself; use [FloatingTime.In] to materialize it in
+// a specific location. The zero value is midnight of January 1, year 1.
+type FloatingTime struct {
+	wall time.Time // the wall clock as a time in UTC
+}
+
+// NewFloatingTime returns the floating time of the given date and time of day.
+// Values outside of their usual ranges are normalized like in [time.Date].
+func NewFloatingTime(year int, month time.Month, day, hour, minute, sec, nsec int) FloatingTime {
+	return FloatingTime{wall: time.Date(year, month, day, hour, minute, sec, nsec, time.UTC)}
+}
+
+// Floating returns the date and time shown by the wall clock of t, without its
+// location.
+func Floating(t time.Time) FloatingTime {
+	return FloatingTime{wall: wallClock(t)}
+}
+
+// ParseFloatingTime parses a floating time in the form
+// "2006-01-02T15:04:05.999999999", where the seconds and fractional seconds are
+// optional. Values that contain a zone offset are rejected, because they are
+// not floating.
+func ParseFloatingTime(s string) (FloatingTime, error) {
+	for _, layout := range []string{floatingLayout, "2006-01-02T15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return FloatingTime{wall: t}, nil
+		}
+	}
+	return FloatingTime{}, fmt.Errorf("invalid floating time %q", s)
+}
+
+// String returns the floating time in the form "2006-01-02T15:04:05.999999999".
+func (f FloatingTime) String() string {
+	return f.wall.Format(floatingLayout)
+}
+
+// MarshalText implements [encoding.TextMarshaler] using [FloatingTime.String].
+func (f FloatingTime) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] using
+// [ParseFloatingTime].
+func (f *FloatingTime) UnmarshalText(text []byte) error {
+	parsed, err := ParseFloatingTime(string(text))
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
+
+// IsZero returns whether f is the zero floating time.
+func (f FloatingTime) IsZero() bool {
+	return f.wall.IsZero()
+}
+
+// In returns the instant at which the wall clock in loc shows the floating
+// time. Wall times that do not exist or that occur twice in loc are resolved
+// using the [GapPolicy] and [AmbiguityPolicy] configured by [OnGap] and
+// [OnAmbiguity]. If a policy rejects the wall time, the returned error wraps
+// [ErrNonexistentTime] or [ErrAmbiguousTime].
+func (f FloatingTime) In(loc *time.Location, opts ...WallClockOption) (time.Time, error) {
+	return resolveWallClock(f.wall, loc, newWallClockConfig(opts))
+}
+
+// Wall returns the floating time as a [time.Time] in UTC that shows the same
+// wall clock. The result is only meaningful for its date and clock fields.
+func (f FloatingTime) Wall() time.Time {
+	return f.wall
+}
+
+// Date returns the date of the floating time.
+func (f FloatingTime) Date() Date {
+	return DateOf(f.wall)
+}
+
+// Clock returns the hour, minute and second of the floating time.
+func (f FloatingTime) Clock() (hour, minute, sec int) {
+	return f.wall.Clock()
+}
+
+// Add returns the floating time moved by d on the wall clock.
+func (f FloatingTime) Add(d time.Duration) FloatingTime {
+	return FloatingTime{wall: f.wall.Add(d)}
+}
+
+// AddDate returns the floating time moved by the given number of years, months
+// and days, normalized like in [time.Time.AddDate].
+func (f FloatingTime) AddDate(years, months, days int) FloatingTime {
+	return FloatingTime{wall: f.wall.AddDate(years, months, days)}
+}
+
+// Sub returns the difference between the wall clocks of f and o.
+func (f FloatingTime) Sub(o FloatingTime) time.Duration {
+	return f.wall.Sub(o.wall)
+}
+
+// Compare returns -1 if f is before o, +1 if f is after o, and 0 if both show
+// the same wall clock.
+func (f FloatingTime) Compare(o FloatingTime) int {
+	return f.wall.Compare(o.wall)
+}
+
+// Before returns whether f is before o.
+func (f FloatingTime) Before(o FloatingTime) bool {
+	return f.wall.Before(o.wall)
+}
+
+// After returns whether f is after o.
+func (f FloatingTime) After(o FloatingTime) bool {
+	return f.wall.After(o.wall)
+}
@@ -0,0 +1,119 @@
+package timefn_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloatingTime_In(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	nine := timefn.NewFloatingTime(2023, time.March, 1, 9, 0, 0, 0)
+
+	for _, loc := range []*time.Location{time.UTC, berlin, tokyo} {
+		got, err := nine.In(loc)
+		if err != nil {
+			t.Fatalf("In(%s) failed: %v", loc, err)
+		}
+		assert.Equal(t, time.Date(2023, time.March, 1, 9, 0, 0, 0, loc), got)
+	}
+
+	gap := timefn.NewFloatingTime(2023, time.March, 26, 2, 30, 0, 0)
+
+	got, err := gap.In(berlin)
+	if err != nil {
+		t.Fatalf("In() failed: %v", err)
+	}
+	assert.True(t, time.Date(2023, time.March, 26, 3, 30, 0, 0, berlin).Equal(got))
+
+	if _, err := gap.In(berlin, timefn.OnGap(timefn.GapReject)); !errors.Is(err, timefn.ErrNonexistentTime) {
+		t.Errorf("In() with GapReject should fail with %v; got %v", timefn.ErrNonexistentTime, err)
+	}
+}
+
+func TestFloating(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	f := timefn.Floating(time.Date(2023, time.March, 1, 9, 15, 30, 0, tokyo))
+	assert.Equal(t, timefn.NewFloatingTime(2023, time.March, 1, 9, 15, 30, 0), f)
+	assert.Equal(t, timefn.Date{Year: 2023, Month: time.March, Day: 1}, f.Date())
+
+	h, m, s := f.Clock()
+	assert.Equal(t, []int{9, 15, 30}, []int{h, m, s})
+	assert.Equal(t, time.Date(2023, time.March, 1, 9, 15, 30, 0, time.UTC), f.Wall())
+}
+
+func TestParseFloatingTime(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    timefn.FloatingTime
+		wantErr bool
+	}{
+		{input: "2023-03-01T09:00", want: timefn.NewFloatingTime(2023, time.March, 1, 9, 0, 0, 0)},
+		{input: "2023-03-01T09:00:30", want: timefn.NewFloatingTime(2023, time.March, 1, 9, 0, 30, 0)},
+		{input: "2023-03-01T09:00:30.5", want: timefn.NewFloatingTime(2023, time.March, 1, 9, 0, 30, 500000000)},
+		{input: "2023-03-01T09:00:00Z", wantErr: true},
+		{input: "2023-03-01T09:00:00+01:00", wantErr: true},
+		{input: "09:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := timefn.ParseFloatingTime(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseFloatingTime(%q) should fail", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFloatingTime(%q) failed: %v", tt.input, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFloatingTime_JSON(t *testing.T) {
+	f := timefn.NewFloatingTime(2023, time.March, 1, 9, 0, 0, 0)
+
+	b, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	assert.Equal(t, `"2023-03-01T09:00:00"`, string(b))
+
+	var got timefn.FloatingTime
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	assert.Equal(t, f, got)
+}
+
+func TestFloatingTime_Arithmetic(t *testing.T) {
+	f := timefn.NewFloatingTime(2023, time.January, 31, 9, 0, 0, 0)
+
+	assert.Equal(t, timefn.NewFloatingTime(2023, time.January, 31, 10, 30, 0, 0), f.Add(90*time.Minute))
+	assert.Equal(t, timefn.NewFloatingTime(2023, time.February, 1, 9, 0, 0, 0), f.AddDate(0, 0, 1))
+	assert.Equal(t, 24*time.Hour, f.AddDate(0, 0, 1).Sub(f))
+	assert.True(t, f.Before(f.Add(time.Nanosecond)))
+	assert.True(t, f.After(f.Add(-time.Nanosecond)))
+	assert.Equal(t, 0, f.Compare(f))
+	assert.False(t, f.IsZero())
+	assert.True(t, timefn.FloatingTime{}.IsZero())
+}
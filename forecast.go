@@ -0,0 +1,71 @@
+package timefn
+
+import "time"
+
+// ForecastCompletion linearly extrapolates when work will be completed, given
+// that done out of total units of work were completed during the elapsed
+// period. The work is assumed to continue at the same rate after the end of
+// the elapsed period. If the work is already complete, the end of the elapsed
+// period is returned. ForecastCompletion returns false if elapsed is not valid
+// or has no duration, or if no progress was made.
+func ForecastCompletion(done, total float64, elapsed Period) (time.Time, bool) {
+	if elapsed.Validate() != nil || elapsed.Duration() <= 0 || done <= 0 {
+		return time.Time{}, false
+	}
+
+	remaining, ok := forecastRemaining(done, total, elapsed.Duration())
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return elapsed.End.Add(remaining), true
+}
+
+// ForecastCompletionBusiness is like [ForecastCompletion], but work only
+// progresses during the given business hours. The rate is measured over the
+// open hours within the elapsed period, and the remaining work is scheduled
+// into the open hours after it. The completion time is returned in the
+// location of the business hours. ForecastCompletionBusiness also returns
+// false if there are no open hours within the elapsed period, or within five
+// years after it.
+func ForecastCompletionBusiness(done, total float64, elapsed Period, hours BusinessHours) (time.Time, bool) {
+	if elapsed.Validate() != nil || done <= 0 {
+		return time.Time{}, false
+	}
+
+	open := TotalDuration(hours.Periods(elapsed))
+	if open <= 0 {
+		return time.Time{}, false
+	}
+
+	remaining, ok := forecastRemaining(done, total, open)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	end := elapsed.End
+	if hours.Location != nil {
+		end = end.In(hours.Location)
+	}
+	if remaining == 0 {
+		return end, true
+	}
+
+	return addBusinessTime(end, remaining, hours.Schedule, hours.Days)
+}
+
+// forecastRemaining returns the time needed to complete the remaining work at
+// the rate of done units per spent duration. It returns false if the result
+// does not fit into a [time.Duration].
+func forecastRemaining(done, total float64, spent time.Duration) (time.Duration, bool) {
+	if done >= total {
+		return 0, true
+	}
+
+	remaining := (total - done) / done * float64(spent)
+	if remaining >= float64(1<<63-1) {
+		return 0, false
+	}
+
+	return time.Duration(remaining), true
+}
@@ -0,0 +1,98 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestForecastCompletion(t *testing.T) {
+	elapsed := timefn.Period{
+		Start: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.March, 11, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name    string
+		done    float64
+		total   float64
+		elapsed timefn.Period
+		want    time.Time
+		wantOK  bool
+	}{
+		{
+			name:    "quarter done",
+			done:    25,
+			total:   100,
+			elapsed: elapsed,
+			want:    time.Date(2023, time.April, 10, 0, 0, 0, 0, time.UTC),
+			wantOK:  true,
+		},
+		{
+			name:    "already complete",
+			done:    120,
+			total:   100,
+			elapsed: elapsed,
+			want:    elapsed.End,
+			wantOK:  true,
+		},
+		{
+			name:    "no progress",
+			total:   100,
+			elapsed: elapsed,
+		},
+		{
+			name:    "open elapsed period",
+			done:    25,
+			total:   100,
+			elapsed: timefn.Period{Start: elapsed.Start},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := timefn.ForecastCompletion(tt.done, tt.total, tt.elapsed)
+			if ok != tt.wantOK {
+				t.Fatalf("ForecastCompletion() ok = %v; want %v", ok, tt.wantOK)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ForecastCompletion() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForecastCompletionBusiness(t *testing.T) {
+	nineToFive := []timefn.DailyWindow{{Start: 9 * time.Hour, End: 17 * time.Hour}}
+	hours := timefn.BusinessHours{
+		Schedule: timefn.WeeklySchedule{
+			time.Monday:    nineToFive,
+			time.Tuesday:   nineToFive,
+			time.Wednesday: nineToFive,
+			time.Thursday:  nineToFive,
+			time.Friday:    nineToFive,
+		},
+		Location: time.UTC,
+	}
+
+	// Monday to Wednesday evening, 24 open hours.
+	elapsed := timefn.Period{
+		Start: time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.March, 8, 20, 0, 0, 0, time.UTC),
+	}
+
+	got, ok := timefn.ForecastCompletionBusiness(60, 100, elapsed, hours)
+	if !ok {
+		t.Fatalf("ForecastCompletionBusiness() returned false")
+	}
+
+	// 16 more open hours: Thursday and Friday.
+	if want := time.Date(2023, time.March, 10, 17, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ForecastCompletionBusiness() = %v; want %v", got, want)
+	}
+
+	if _, ok := timefn.ForecastCompletionBusiness(60, 100, elapsed, timefn.BusinessHours{}); ok {
+		t.Errorf("ForecastCompletionBusiness() without open hours should return false")
+	}
+}
@@ -0,0 +1,224 @@
+package timefn
+
+import (
+	"slices"
+	"time"
+)
+
+// HolidayProvider provides the holidays of a region. Use
+// [WithHolidayProvider] to close the holidays of a provider in a
+// [BusinessCalendar].
+type HolidayProvider interface {
+	// Holidays returns the dates of the holidays in the given year. Only the
+	// wall date of each returned time is relevant. Observed holidays may fall
+	// into the adjacent year, like an observed New Year's Day on December 31.
+	Holidays(year int) []time.Time
+}
+
+// observance describes how a holiday that falls on a weekend is moved.
+type observance int
+
+const (
+	observeNever observance = iota
+
+	// observeNearest moves Saturday holidays to Friday and Sunday holidays
+	// to Monday, as done for US federal holidays.
+	observeNearest
+
+	// observeSubstitute moves weekend holidays to the next weekday that is not
+	// a holiday itself, as done for bank holidays in the United Kingdom.
+	observeSubstitute
+)
+
+// HolidayRule computes the date of a recurring holiday. Use [FixedHoliday],
+// [NthWeekdayHoliday] or [EasterHoliday] to create one, and combine rules in
+// [HolidayRules].
+type HolidayRule struct {
+	// Name is the name of the holiday, such as "Christmas Day".
+	Name string
+
+	date    func(year int) time.Time
+	from    int
+	until   int
+	observe observance
+}
+
+// FixedHoliday returns a [HolidayRule] for a holiday that falls on the same
+// date every year, such as Christmas Day on December 25.
+func FixedHoliday(name string, month time.Month, day int) HolidayRule {
+	return HolidayRule{Name: name, date: func(year int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}}
+}
+
+// NthWeekdayHoliday returns a [HolidayRule] for a holiday that falls on the
+// n-th weekday of a month, such as Thanksgiving on the fourth Thursday of
+// November. A negative n counts from the end of the month, so -1 is the last
+// weekday of the month.
+func NthWeekdayHoliday(name string, month time.Month, weekday time.Weekday, n int) HolidayRule {
+	return HolidayRule{Name: name, date: func(year int) time.Time {
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		days := nthWeekdays(first, first.AddDate(0, 1, 0), NthWeekday{Weekday: weekday, N: n})
+		if len(days) == 0 {
+			return time.Time{}
+		}
+		return days[0]
+	}}
+}
+
+// EasterHoliday returns a [HolidayRule] for a holiday that falls the given
+// number of days after Easter Sunday, such as Good Friday (-2) or Whit Monday
+// (50). Easter is computed using the Gregorian calendar.
+func EasterHoliday(name string, offset int) HolidayRule {
+	return HolidayRule{Name: name, date: func(year int) time.Time {
+		return Easter(year).AddDate(0, 0, offset)
+	}}
+}
+
+// Easter returns the date of Easter Sunday in the given year, according to the
+// Gregorian calendar, as midnight in UTC.
+func Easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// From returns a copy of the rule that only applies from the given year on.
+func (r HolidayRule) From(year int) HolidayRule {
+	r.from = year
+	return r
+}
+
+// Until returns a copy of the rule that only applies up to and including the
+// given year.
+func (r HolidayRule) Until(year int) HolidayRule {
+	r.until = year
+	return r
+}
+
+// Observed returns a copy of the rule that moves the holiday to the preceding
+// Friday if it falls on a Saturday, and to the following Monday if it falls on
+// a Sunday, as done for US federal holidays.
+func (r HolidayRule) Observed() HolidayRule {
+	r.observe = observeNearest
+	return r
+}
+
+// Substituted returns a copy of the rule that moves the holiday to the next
+// weekday that is not already a holiday if it falls on a weekend, as done for
+// bank holidays in the United Kingdom.
+func (r HolidayRule) Substituted() HolidayRule {
+	r.observe = observeSubstitute
+	return r
+}
+
+// Date returns the actual date of the holiday in the given year, before it is
+// moved by [HolidayRule.Observed] or [HolidayRule.Substituted]. It returns
+// false if the rule does not apply in that year.
+func (r HolidayRule) Date(year int) (time.Time, bool) {
+	if r.date == nil || (r.from != 0 && year < r.from) || (r.until != 0 && year > r.until) {
+		return time.Time{}, false
+	}
+
+	d := r.date(year)
+	return d, !d.IsZero()
+}
+
+// HolidayRules is a [HolidayProvider] that computes holidays from rules.
+type HolidayRules []HolidayRule
+
+// Holidays implements [HolidayProvider]. The dates are returned as midnight in
+// UTC, sorted and without duplicates. Holidays of substituted rules are moved
+// after all other holidays of the year are known, so that they do not fall on
+// another holiday.
+func (rs HolidayRules) Holidays(year int) []time.Time {
+	taken := make(map[time.Time]bool)
+	var substitutes []time.Time
+
+	for _, r := range rs {
+		d, ok := r.Date(year)
+		if !ok {
+			continue
+		}
+
+		weekend := d.Weekday() == time.Saturday || d.Weekday() == time.Sunday
+		switch {
+		case weekend && r.observe == observeNearest && d.Weekday() == time.Saturday:
+			d = d.AddDate(0, 0, -1)
+		case weekend && r.observe == observeNearest:
+			d = d.AddDate(0, 0, 1)
+		case weekend && r.observe == observeSubstitute:
+			substitutes = append(substitutes, d)
+			continue
+		}
+		taken[d] = true
+	}
+
+	for _, d := range substitutes {
+		for d.Weekday() == time.Saturday || d.Weekday() == time.Sunday || taken[d] {
+			d = d.AddDate(0, 0, 1)
+		}
+		taken[d] = true
+	}
+
+	out := make([]time.Time, 0, len(taken))
+	for d := range taken {
+		out = append(out, d)
+	}
+	slices.SortFunc(out, time.Time.Compare)
+
+	return out
+}
+
+// USFederalHolidays are the federal holidays of the United States. Holidays
+// that fall on a weekend are observed on the nearest weekday.
+var USFederalHolidays = HolidayRules{
+	FixedHoliday("New Year's Day", time.January, 1).Observed(),
+	NthWeekdayHoliday("Birthday of Martin Luther King, Jr.", time.January, time.Monday, 3).From(1986),
+	NthWeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+	NthWeekdayHoliday("Memorial Day", time.May, time.Monday, -1),
+	FixedHoliday("Juneteenth National Independence Day", time.June, 19).From(2021).Observed(),
+	FixedHoliday("Independence Day", time.July, 4).Observed(),
+	NthWeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+	NthWeekdayHoliday("Columbus Day", time.October, time.Monday, 2),
+	FixedHoliday("Veterans Day", time.November, 11).Observed(),
+	NthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+	FixedHoliday("Christmas Day", time.December, 25).Observed(),
+}
+
+// GermanHolidays are the public holidays that apply in all states of Germany.
+// Holidays of individual states are not included.
+var GermanHolidays = HolidayRules{
+	FixedHoliday("Neujahr", time.January, 1),
+	EasterHoliday("Karfreitag", -2),
+	EasterHoliday("Ostermontag", 1),
+	FixedHoliday("Tag der Arbeit", time.May, 1),
+	EasterHoliday("Christi Himmelfahrt", 39),
+	EasterHoliday("Pfingstmontag", 50),
+	FixedHoliday("Tag der Deutschen Einheit", time.October, 3).From(1990),
+	FixedHoliday("1. Weihnachtstag", time.December, 25),
+	FixedHoliday("2. Weihnachtstag", time.December, 26),
+}
+
+// EnglandHolidays are the bank holidays of England and Wales. Holidays that
+// fall on a weekend are substituted by the next free weekday. One-off bank
+// holidays and moved holidays, such as for royal events, are not included.
+var EnglandHolidays = HolidayRules{
+	FixedHoliday("New Year's Day", time.January, 1).Substituted(),
+	EasterHoliday("Good Friday", -2),
+	EasterHoliday("Easter Monday", 1),
+	NthWeekdayHoliday("Early May bank holiday", time.May, time.Monday, 1),
+	NthWeekdayHoliday("Spring bank holiday", time.May, time.Monday, -1),
+	NthWeekdayHoliday("Summer bank holiday", time.August, time.Monday, -1),
+	FixedHoliday("Christmas Day", time.December, 25).Substituted(),
+	FixedHoliday("Boxing Day", time.December, 26).Substituted(),
+}
@@ -0,0 +1,171 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestEaster(t *testing.T) {
+	tests := map[int]time.Time{
+		2000: time.Date(2000, time.April, 23, 0, 0, 0, 0, time.UTC),
+		2019: time.Date(2019, time.April, 21, 0, 0, 0, 0, time.UTC),
+		2023: time.Date(2023, time.April, 9, 0, 0, 0, 0, time.UTC),
+		2024: time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+		2038: time.Date(2038, time.April, 25, 0, 0, 0, 0, time.UTC),
+	}
+
+	for year, want := range tests {
+		if got := timefn.Easter(year); !got.Equal(want) {
+			t.Errorf("Easter(%d) = %v, want %v", year, got, want)
+		}
+	}
+}
+
+func TestHolidayRules_Holidays(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		provider timefn.HolidayProvider
+		year     int
+		want     []time.Time
+	}{
+		{
+			name:     "US 2021",
+			provider: timefn.USFederalHolidays,
+			year:     2021,
+			want: []time.Time{
+				date(2021, time.January, 1),
+				date(2021, time.January, 18),
+				date(2021, time.February, 15),
+				date(2021, time.May, 31),
+				date(2021, time.June, 18),
+				date(2021, time.July, 5),
+				date(2021, time.September, 6),
+				date(2021, time.October, 11),
+				date(2021, time.November, 11),
+				date(2021, time.November, 25),
+				date(2021, time.December, 24),
+			},
+		},
+		{
+			name:     "US 2022, New Year's Day observed in 2021",
+			provider: timefn.USFederalHolidays,
+			year:     2022,
+			want: []time.Time{
+				date(2021, time.December, 31),
+				date(2022, time.January, 17),
+				date(2022, time.February, 21),
+				date(2022, time.May, 30),
+				date(2022, time.June, 20),
+				date(2022, time.July, 4),
+				date(2022, time.September, 5),
+				date(2022, time.October, 10),
+				date(2022, time.November, 11),
+				date(2022, time.November, 24),
+				date(2022, time.December, 26),
+			},
+		},
+		{
+			name:     "Germany 2023",
+			provider: timefn.GermanHolidays,
+			year:     2023,
+			want: []time.Time{
+				date(2023, time.January, 1),
+				date(2023, time.April, 7),
+				date(2023, time.April, 10),
+				date(2023, time.May, 1),
+				date(2023, time.May, 18),
+				date(2023, time.May, 29),
+				date(2023, time.October, 3),
+				date(2023, time.December, 25),
+				date(2023, time.December, 26),
+			},
+		},
+		{
+			name:     "England 2021, substituted Christmas and Boxing Day",
+			provider: timefn.EnglandHolidays,
+			year:     2021,
+			want: []time.Time{
+				date(2021, time.January, 1),
+				date(2021, time.April, 2),
+				date(2021, time.April, 5),
+				date(2021, time.May, 3),
+				date(2021, time.May, 31),
+				date(2021, time.August, 30),
+				date(2021, time.December, 27),
+				date(2021, time.December, 28),
+			},
+		},
+		{
+			name:     "England 2022, Boxing Day on Monday",
+			provider: timefn.EnglandHolidays,
+			year:     2022,
+			want: []time.Time{
+				date(2022, time.January, 3),
+				date(2022, time.April, 15),
+				date(2022, time.April, 18),
+				date(2022, time.May, 2),
+				date(2022, time.May, 30),
+				date(2022, time.August, 29),
+				date(2022, time.December, 26),
+				date(2022, time.December, 27),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.provider.Holidays(tt.year)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("Holidays(%d)[%d] = %v, want %v", tt.year, i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestHolidayRule_From(t *testing.T) {
+	rule := timefn.FixedHoliday("Juneteenth", time.June, 19).From(2021).Until(2030)
+
+	if _, ok := rule.Date(2020); ok {
+		t.Errorf("expected rule not to apply before 2021")
+	}
+
+	if _, ok := rule.Date(2031); ok {
+		t.Errorf("expected rule not to apply after 2030")
+	}
+
+	if d, ok := rule.Date(2025); !ok || !d.Equal(time.Date(2025, time.June, 19, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Date(2025) = %v, %v, want 2025-06-19, true", d, ok)
+	}
+}
+
+func TestBusinessCalendar_WithHolidayProvider(t *testing.T) {
+	cal := timefn.NewBusinessCalendar(timefn.WithHolidayProvider(timefn.USFederalHolidays))
+
+	// New Year's Day 2022 is observed on Friday, December 31, 2021.
+	dec31 := time.Date(2021, time.December, 31, 12, 0, 0, 0, time.UTC)
+	if cal.IsBusinessDay(dec31) {
+		t.Errorf("expected observed New Year's Day to be a holiday")
+	}
+
+	if got, want := cal.NextBusinessDay(time.Date(2021, time.December, 30, 9, 0, 0, 0, time.UTC)), time.Date(2022, time.January, 3, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("NextBusinessDay() = %v, want %v", got, want)
+	}
+
+	// December 2021 has 23 weekdays, 2 of which are observed holidays.
+	from := time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if got := cal.BusinessDaysBetween(from, to); got != 21 {
+		t.Errorf("BusinessDaysBetween() = %d, want 21", got)
+	}
+}
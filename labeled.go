@@ -0,0 +1,95 @@
+package timefn
+
+import (
+	"slices"
+	"time"
+
+	"github.com/bounoable/timefn/internal/slice"
+)
+
+// LabeledPeriod is a [Period] with a label attached to it, such as the project
+// a tracked time span was spent on.
+type LabeledPeriod struct {
+	Period Period `json:"period"`
+	Label  string `json:"label"`
+}
+
+// DurationsByLabel returns the total duration covered by the periods of each
+// label. Periods with the same label are merged before their durations are
+// summed up, so overlapping periods within a label are not counted twice.
+// Periods of different labels are independent of each other. Inverted periods
+// are normalized, and empty or open-ended periods are ignored because they do
+// not have a finite duration.
+func DurationsByLabel(periods []LabeledPeriod) map[string]time.Duration {
+	byLabel := make(map[string][]Period)
+	for _, lp := range periods {
+		if lp.Period.Start.IsZero() || lp.Period.End.IsZero() {
+			continue
+		}
+		byLabel[lp.Label] = append(byLabel[lp.Label], lp.Period)
+	}
+
+	out := make(map[string]time.Duration, len(byLabel))
+	for label, periods := range byLabel {
+		out[label] = TotalDuration(periods)
+	}
+
+	return out
+}
+
+// CutLabeled cuts the given periods out of the base period, like [Period.Cut].
+// The remaining fragments keep the label of the base period and are sorted by
+// their start times. Use [CutLabeledWithRemoved] to also find out which cut
+// removed which part of the base period.
+func CutLabeled(base LabeledPeriod, cuts []LabeledPeriod) []LabeledPeriod {
+	remaining, _ := CutLabeledWithRemoved(base, cuts)
+	return remaining
+}
+
+// CutLabeledWithRemoved cuts the given periods out of the base period, like
+// [CutLabeled], and additionally returns the removed parts of the base period.
+// Each removed part carries the label of the cut that removed it. If multiple
+// cuts overlap, the overlapping part is attributed to the cut that sorts first
+// according to [ComparePeriods]. Removed parts are returned in the order of
+// the cuts that removed them.
+//
+// The cuts are sorted once and then applied in a single sweep, so
+// CutLabeledWithRemoved runs in O(m log m) time for m cuts. The given cuts are
+// not modified.
+func CutLabeledWithRemoved(base LabeledPeriod, cuts []LabeledPeriod) (remaining, removed []LabeledPeriod) {
+	cuts = slices.Clone(cuts)
+	slices.SortStableFunc(cuts, func(a, b LabeledPeriod) int {
+		return ComparePeriods(a.Period, b.Period)
+	})
+
+	periods := slice.Map(cuts, func(c LabeledPeriod) Period { return c.Period })
+	remaining = slice.Map(base.Period.Cut(periods...), func(p Period) LabeledPeriod {
+		return LabeledPeriod{Period: p, Label: base.Label}
+	})
+
+	// The cuts are sorted by their start, so the parts of a cut that earlier
+	// cuts already removed end at the latest end of the removed parts so far.
+	var last Period
+	for _, c := range cuts {
+		part, ok := base.Period.intersection(c.Period)
+		if !ok {
+			continue
+		}
+
+		if !last.IsZero() {
+			_, lastEnd := last.bounds()
+			partStart, partEnd := part.bounds()
+			if !lastEnd.Before(partEnd) {
+				continue
+			}
+			if lastEnd.After(partStart) {
+				part.Start = last.End
+			}
+		}
+
+		removed = append(removed, LabeledPeriod{Period: part, Label: c.Label})
+		last = part
+	}
+
+	return remaining, removed
+}
@@ -0,0 +1,106 @@
+package timefn_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestDurationsByLabel(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	periods := []timefn.LabeledPeriod{
+		{Label: "a", Period: timefn.Period{Start: jan1, End: jan1.Add(2 * time.Hour)}},
+		{Label: "a", Period: timefn.Period{Start: jan1.Add(time.Hour), End: jan1.Add(3 * time.Hour)}},
+		{Label: "a", Period: timefn.Period{Start: jan1.Add(5 * time.Hour), End: jan1.Add(4 * time.Hour)}},
+		{Label: "b", Period: timefn.Period{Start: jan1, End: jan1.Add(time.Hour)}},
+		{Label: "b", Period: timefn.Period{Start: jan1}},
+		{Label: "c", Period: timefn.Period{}},
+	}
+
+	got := timefn.DurationsByLabel(periods)
+	want := map[string]time.Duration{
+		"a": 4 * time.Hour,
+		"b": time.Hour,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DurationsByLabel() = %v, want %v", got, want)
+	}
+}
+
+func TestCutLabeledWithRemoved(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return jan1.Add(time.Duration(h) * time.Hour) }
+
+	base := timefn.LabeledPeriod{Label: "shift", Period: timefn.Period{Start: hour(8), End: hour(18)}}
+	cuts := []timefn.LabeledPeriod{
+		{Label: "meeting", Period: timefn.Period{Start: hour(14), End: hour(15)}},
+		{Label: "lunch", Period: timefn.Period{Start: hour(12), End: hour(13)}},
+		{Label: "overtime", Period: timefn.Period{Start: hour(17), End: hour(20)}},
+		{Label: "outside", Period: timefn.Period{Start: hour(20), End: hour(21)}},
+	}
+
+	remaining, removed := timefn.CutLabeledWithRemoved(base, cuts)
+
+	wantRemaining := []timefn.LabeledPeriod{
+		{Label: "shift", Period: timefn.Period{Start: hour(8), End: hour(12)}},
+		{Label: "shift", Period: timefn.Period{Start: hour(13), End: hour(14)}},
+		{Label: "shift", Period: timefn.Period{Start: hour(15), End: hour(17)}},
+	}
+	wantRemoved := []timefn.LabeledPeriod{
+		{Label: "lunch", Period: timefn.Period{Start: hour(12), End: hour(13)}},
+		{Label: "meeting", Period: timefn.Period{Start: hour(14), End: hour(15)}},
+		{Label: "overtime", Period: timefn.Period{Start: hour(17), End: hour(18)}},
+	}
+
+	if !reflect.DeepEqual(remaining, wantRemaining) {
+		t.Errorf("remaining = %v, want %v", remaining, wantRemaining)
+	}
+
+	if !reflect.DeepEqual(removed, wantRemoved) {
+		t.Errorf("removed = %v, want %v", removed, wantRemoved)
+	}
+
+	if got := timefn.CutLabeled(base, cuts); !reflect.DeepEqual(got, wantRemaining) {
+		t.Errorf("CutLabeled() = %v, want %v", got, wantRemaining)
+	}
+
+	if cuts[0].Label != "meeting" {
+		t.Errorf("CutLabeled() should not reorder the given cuts")
+	}
+}
+
+func TestCutLabeledWithRemoved_overlappingCuts(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return jan1.Add(time.Duration(h) * time.Hour) }
+
+	base := timefn.LabeledPeriod{Label: "shift", Period: timefn.Period{Start: hour(8), End: hour(18)}}
+	cuts := []timefn.LabeledPeriod{
+		{Label: "call", Period: timefn.Period{Start: hour(10), End: hour(11)}},
+		{Label: "workshop", Period: timefn.Period{Start: hour(9), End: hour(12)}},
+		{Label: "lunch", Period: timefn.Period{Start: hour(11), End: hour(13)}},
+		{Label: "break", Period: timefn.Period{Start: hour(7), End: hour(8)}},
+	}
+
+	remaining, removed := timefn.CutLabeledWithRemoved(base, cuts)
+
+	wantRemaining := []timefn.LabeledPeriod{
+		{Label: "shift", Period: timefn.Period{Start: hour(8), End: hour(9)}},
+		{Label: "shift", Period: timefn.Period{Start: hour(13), End: hour(18)}},
+	}
+	wantRemoved := []timefn.LabeledPeriod{
+		{Label: "workshop", Period: timefn.Period{Start: hour(9), End: hour(12)}},
+		{Label: "lunch", Period: timefn.Period{Start: hour(12), End: hour(13)}},
+	}
+
+	if !reflect.DeepEqual(remaining, wantRemaining) {
+		t.Errorf("remaining = %v, want %v", remaining, wantRemaining)
+	}
+
+	if !reflect.DeepEqual(removed, wantRemoved) {
+		t.Errorf("removed = %v, want %v", removed, wantRemoved)
+	}
+}
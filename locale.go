@@ -0,0 +1,202 @@
+package timefn
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Locale provides the names of months, weekdays and duration units in a
+// language. Locales are used by [Locale.Format], by [FormatDuration] with
+// [WithLocale], and by the "formatLocale" function of period formats, see
+// [Period.FormatAs]. Custom locales can be made available by name using
+// [RegisterLocale].
+type Locale struct {
+	// Months are the names of the months, starting with January.
+	Months [12]string
+
+	// ShortMonths are the abbreviated names of the months, starting with
+	// January.
+	ShortMonths [12]string
+
+	// Weekdays are the names of the weekdays, starting with Sunday, so that
+	// they can be indexed by [time.Weekday].
+	Weekdays [7]string
+
+	// ShortWeekdays are the abbreviated names of the weekdays, starting with
+	// Sunday.
+	ShortWeekdays [7]string
+
+	// Days, Hours, Minutes, Seconds and Milliseconds are the names of the
+	// duration units used by [FormatDuration].
+	Days, Hours, Minutes, Seconds, Milliseconds UnitNames
+}
+
+// UnitNames are the singular and plural names of a unit, such as "day" and
+// "days".
+type UnitNames struct {
+	One   string
+	Other string
+}
+
+// English is the English locale, which is used if no locale is given.
+var English = &Locale{
+	Months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	ShortMonths:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	Weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	Days:          UnitNames{"day", "days"},
+	Hours:         UnitNames{"hour", "hours"},
+	Minutes:       UnitNames{"minute", "minutes"},
+	Seconds:       UnitNames{"second", "seconds"},
+	Milliseconds:  UnitNames{"millisecond", "milliseconds"},
+}
+
+// German is the German locale.
+var German = &Locale{
+	Months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	ShortMonths:   [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+	Weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	ShortWeekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	Days:          UnitNames{"Tag", "Tage"},
+	Hours:         UnitNames{"Stunde", "Stunden"},
+	Minutes:       UnitNames{"Minute", "Minuten"},
+	Seconds:       UnitNames{"Sekunde", "Sekunden"},
+	Milliseconds:  UnitNames{"Millisekunde", "Millisekunden"},
+}
+
+// French is the French locale.
+var French = &Locale{
+	Months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	ShortMonths:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	Weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	ShortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	Days:          UnitNames{"jour", "jours"},
+	Hours:         UnitNames{"heure", "heures"},
+	Minutes:       UnitNames{"minute", "minutes"},
+	Seconds:       UnitNames{"seconde", "secondes"},
+	Milliseconds:  UnitNames{"milliseconde", "millisecondes"},
+}
+
+// Spanish is the Spanish locale.
+var Spanish = &Locale{
+	Months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	ShortMonths:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+	Weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	ShortWeekdays: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	Days:          UnitNames{"día", "días"},
+	Hours:         UnitNames{"hora", "horas"},
+	Minutes:       UnitNames{"minuto", "minutos"},
+	Seconds:       UnitNames{"segundo", "segundos"},
+	Milliseconds:  UnitNames{"milisegundo", "milisegundos"},
+}
+
+var (
+	localesMux sync.RWMutex
+	locales    = map[string]*Locale{
+		"en": English,
+		"de": German,
+		"fr": French,
+		"es": Spanish,
+	}
+)
+
+// RegisterLocale makes the locale available under the given language tag,
+// such as "de" or "pt-BR", for [LookupLocale] and period formats. The locales
+// "en", "de", "fr" and "es" are registered by default and can be replaced.
+// RegisterLocale is safe for concurrent use.
+func RegisterLocale(tag string, l *Locale) {
+	localesMux.Lock()
+	defer localesMux.Unlock()
+	locales[tag] = l
+}
+
+// LookupLocale returns the locale registered under the given language tag.
+// If no locale is registered under the tag, but under its base language, such
+// as "de" for "de-AT", that locale is returned.
+func LookupLocale(tag string) (*Locale, bool) {
+	localesMux.RLock()
+	defer localesMux.RUnlock()
+
+	if l, ok := locales[tag]; ok {
+		return l, true
+	}
+
+	if base, _, ok := strings.Cut(tag, "-"); ok {
+		l, ok := locales[base]
+		return l, ok
+	}
+
+	return nil, false
+}
+
+// Month returns the name of the month m. A nil Locale is English.
+func (l *Locale) Month(m time.Month) string {
+	return l.orEnglish().Months[floorMod(int(m)-1, 12)]
+}
+
+// ShortMonth returns the abbreviated name of the month m.
+func (l *Locale) ShortMonth(m time.Month) string {
+	return l.orEnglish().ShortMonths[floorMod(int(m)-1, 12)]
+}
+
+// Weekday returns the name of the weekday d.
+func (l *Locale) Weekday(d time.Weekday) string {
+	return l.orEnglish().Weekdays[floorMod(int(d), 7)]
+}
+
+// ShortWeekday returns the abbreviated name of the weekday d.
+func (l *Locale) ShortWeekday(d time.Weekday) string {
+	return l.orEnglish().ShortWeekdays[floorMod(int(d), 7)]
+}
+
+// Format formats t like [time.Time.Format], but prints the names of months
+// and weekdays that are requested by "January", "Jan", "Monday" and "Mon" in
+// the layout in the language of the locale.
+func (l *Locale) Format(t time.Time, layout string) string {
+	var b strings.Builder
+	for layout != "" {
+		i, name := nextNameElement(layout)
+		if i < 0 {
+			b.WriteString(t.Format(layout))
+			break
+		}
+
+		b.WriteString(t.Format(layout[:i]))
+		switch name {
+		case "January":
+			b.WriteString(l.Month(t.Month()))
+		case "Jan":
+			b.WriteString(l.ShortMonth(t.Month()))
+		case "Monday":
+			b.WriteString(l.Weekday(t.Weekday()))
+		case "Mon":
+			b.WriteString(l.ShortWeekday(t.Weekday()))
+		}
+		layout = layout[i+len(name):]
+	}
+	return b.String()
+}
+
+// nameElements are the layout elements that print names, longest first.
+var nameElements = [...]string{"January", "Jan", "Monday", "Mon"}
+
+// nextNameElement returns the index and value of the first layout element in
+// layout that prints a name, or -1 if there is none.
+func nextNameElement(layout string) (int, string) {
+	for i := range layout {
+		for _, name := range nameElements {
+			if strings.HasPrefix(layout[i:], name) {
+				return i, name
+			}
+		}
+	}
+	return -1, ""
+}
+
+func (l *Locale) orEnglish() *Locale {
+	if l == nil {
+		return English
+	}
+	return l
+}
@@ -0,0 +1,85 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestLocale_Format(t *testing.T) {
+	tm := time.Date(2024, time.March, 4, 9, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale *timefn.Locale
+		layout string
+		want   string
+	}{
+		{locale: nil, layout: "Monday, 2 January 2006", want: "Monday, 4 March 2024"},
+		{locale: timefn.German, layout: "Monday, 2. January 2006 15:04", want: "Montag, 4. März 2024 09:05"},
+		{locale: timefn.German, layout: "Mon 2. Jan", want: "Mo 4. Mär"},
+		{locale: timefn.French, layout: "Monday 2 January", want: "lundi 4 mars"},
+		{locale: timefn.Spanish, layout: "Mon, 2 Jan 2006", want: "lun, 4 mar 2024"},
+		{locale: timefn.German, layout: "2006-01-02", want: "2024-03-04"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.locale.Format(tm, tt.layout); got != tt.want {
+				t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	if l, ok := timefn.LookupLocale("de"); !ok || l != timefn.German {
+		t.Errorf("LookupLocale(%q) = %v, %v; want German", "de", l, ok)
+	}
+
+	if l, ok := timefn.LookupLocale("de-AT"); !ok || l != timefn.German {
+		t.Errorf("LookupLocale(%q) = %v, %v; want German", "de-AT", l, ok)
+	}
+
+	if _, ok := timefn.LookupLocale("xx"); ok {
+		t.Errorf("LookupLocale(%q) should not find a locale", "xx")
+	}
+
+	custom := &timefn.Locale{Months: [12]string{"M1", "M2", "M3"}}
+	timefn.RegisterLocale("test-custom", custom)
+
+	if l, ok := timefn.LookupLocale("test-custom"); !ok || l != custom {
+		t.Errorf("LookupLocale(%q) should find the registered locale", "test-custom")
+	}
+
+	if got := custom.Month(time.March); got != "M3" {
+		t.Errorf("Month() = %q, want %q", got, "M3")
+	}
+}
+
+func TestFormatDuration_locale(t *testing.T) {
+	d := 2*24*time.Hour + time.Hour + 30*time.Second
+
+	if got, want := timefn.FormatDuration(d, timefn.WithLocale(timefn.German)), "2 Tage 1 Stunde 30 Sekunden"; got != want {
+		t.Errorf("FormatDuration() = %q, want %q", got, want)
+	}
+
+	if got, want := timefn.FormatDuration(0, timefn.WithLocale(timefn.Spanish)), "0 segundos"; got != want {
+		t.Errorf("FormatDuration() = %q, want %q", got, want)
+	}
+}
+
+func TestPeriod_FormatAs_locale(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	if got, want := p.FormatAs(`{{ formatLocale .Start "de" "Monday, 2. January" }}`), "Montag, 4. März"; got != want {
+		t.Errorf("FormatAs() = %q, want %q", got, want)
+	}
+
+	if got, want := (timefn.Period{End: p.End}).FormatAs(`{{ formatLocale .Start "de" "2. January" }}`), "-inf"; got != want {
+		t.Errorf("FormatAs() = %q, want %q", got, want)
+	}
+}
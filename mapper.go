@@ -0,0 +1,70 @@
+package timefn
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// TimeMapper maps real time to simulated time and back. Simulated time passes
+// at a constant speed relative to real time: at a speed of 60, one real second
+// corresponds to one simulated minute. The real anchor corresponds to the
+// simulated anchor. Use [NewTimeMapper] to create a TimeMapper.
+type TimeMapper struct {
+	realAnchor time.Time
+	simAnchor  time.Time
+	speed      float64
+}
+
+// NewTimeMapper returns a [TimeMapper] that maps realAnchor to simAnchor and
+// advances simulated time at the given speed relative to real time. It returns
+// an error if the speed is not a positive, finite number.
+func NewTimeMapper(realAnchor, simAnchor time.Time, speed float64) (TimeMapper, error) {
+	if speed <= 0 || math.IsNaN(speed) || math.IsInf(speed, 0) {
+		return TimeMapper{}, fmt.Errorf("speed must be a positive number; is %v", speed)
+	}
+
+	return TimeMapper{
+		realAnchor: realAnchor,
+		simAnchor:  simAnchor,
+		speed:      speed,
+	}, nil
+}
+
+// Speed returns the speed of simulated time relative to real time.
+func (m TimeMapper) Speed() float64 {
+	return m.speed
+}
+
+// ToSim returns the simulated time that corresponds to the real time t.
+func (m TimeMapper) ToSim(t time.Time) time.Time {
+	return m.simAnchor.Add(time.Duration(math.Round(float64(t.Sub(m.realAnchor)) * m.speed)))
+}
+
+// ToReal returns the real time that corresponds to the simulated time t. It is
+// the inverse of [TimeMapper.ToSim], up to rounding to whole nanoseconds.
+func (m TimeMapper) ToReal(t time.Time) time.Time {
+	return m.realAnchor.Add(time.Duration(math.Round(float64(t.Sub(m.simAnchor)) / m.speed)))
+}
+
+// PeriodToSim maps both boundaries of a real period to simulated time using
+// [TimeMapper.ToSim]. Open boundaries stay open.
+func (m TimeMapper) PeriodToSim(p Period) Period {
+	return mapPeriod(p, m.ToSim)
+}
+
+// PeriodToReal maps both boundaries of a simulated period to real time using
+// [TimeMapper.ToReal]. Open boundaries stay open.
+func (m TimeMapper) PeriodToReal(p Period) Period {
+	return mapPeriod(p, m.ToReal)
+}
+
+func mapPeriod(p Period, fn func(time.Time) time.Time) Period {
+	if !p.Start.IsZero() {
+		p.Start = fn(p.Start)
+	}
+	if !p.End.IsZero() {
+		p.End = fn(p.End)
+	}
+	return p
+}
@@ -0,0 +1,52 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestTimeMapper(t *testing.T) {
+	realStart := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	simStart := time.Date(2000, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	m, err := timefn.NewTimeMapper(realStart, simStart, 60)
+	if err != nil {
+		t.Fatalf("NewTimeMapper() failed: %v", err)
+	}
+
+	if got, want := m.ToSim(realStart.Add(time.Second)), simStart.Add(time.Minute); !got.Equal(want) {
+		t.Errorf("ToSim() = %v, want %v", got, want)
+	}
+
+	if got, want := m.ToSim(realStart.Add(-time.Minute)), simStart.Add(-time.Hour); !got.Equal(want) {
+		t.Errorf("ToSim() = %v, want %v", got, want)
+	}
+
+	if got, want := m.ToReal(simStart.Add(time.Hour)), realStart.Add(time.Minute); !got.Equal(want) {
+		t.Errorf("ToReal() = %v, want %v", got, want)
+	}
+
+	p := timefn.Period{Start: realStart, End: realStart.Add(10 * time.Second)}
+	simPeriod := m.PeriodToSim(p)
+	if want := (timefn.Period{Start: simStart, End: simStart.Add(10 * time.Minute)}); simPeriod != want {
+		t.Errorf("PeriodToSim() = %v, want %v", simPeriod, want)
+	}
+
+	if back := m.PeriodToReal(simPeriod); back != p {
+		t.Errorf("PeriodToReal() = %v, want %v", back, p)
+	}
+
+	if open := m.PeriodToSim(timefn.Period{Start: realStart}); !open.End.IsZero() {
+		t.Errorf("PeriodToSim() should keep open boundaries; got %v", open)
+	}
+}
+
+func TestNewTimeMapper_invalidSpeed(t *testing.T) {
+	for _, speed := range []float64{0, -1} {
+		if _, err := timefn.NewTimeMapper(time.Now(), time.Now(), speed); err == nil {
+			t.Errorf("NewTimeMapper() should fail for speed %v", speed)
+		}
+	}
+}
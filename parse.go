@@ -0,0 +1,134 @@
+package timefn
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParsePeriod parses an ISO 8601 time interval into a [Period]. The following
+// forms are supported:
+//
+//	"2023-01-01T00:00:00Z/2023-02-01T00:00:00Z" (start/end)
+//	"2023-01-01T00:00:00Z/P1M" (start/duration)
+//	"P2W/2023-02-01T00:00:00Z" (duration/end)
+//
+// Times must be formatted as [time.RFC3339] or [time.RFC3339Nano]. Durations
+// are applied using [CalendarDuration.AddTo], so "P1M" adds one calendar month
+// to the start, clamped to the end of shorter months, and the resulting
+// boundary keeps the location of the given one.
+// In the start/end form, either boundary may be ".." to denote an open
+// boundary.
+func ParsePeriod(s string) (Period, error) {
+	first, second, ok := strings.Cut(s, "/")
+	if !ok {
+		return Period{}, fmt.Errorf("parse period %q: missing \"/\" separator", s)
+	}
+
+	firstIsDuration := strings.HasPrefix(first, "P")
+	secondIsDuration := strings.HasPrefix(second, "P")
+
+	switch {
+	case firstIsDuration && secondIsDuration:
+		return Period{}, fmt.Errorf("parse period %q: at most one of start and end may be a duration", s)
+
+	case secondIsDuration:
+		start, err := parseBoundary(first)
+		if err != nil {
+			return Period{}, fmt.Errorf("parse period %q: start: %w", s, err)
+		}
+		if start.IsZero() {
+			return Period{}, fmt.Errorf("parse period %q: start of a start/duration interval must not be open", s)
+		}
+
+		d, err := ParseCalendarDuration(second)
+		if err != nil {
+			return Period{}, fmt.Errorf("parse period %q: duration: %w", s, err)
+		}
+
+		return Period{Start: start, End: d.AddTo(start)}, nil
+
+	case firstIsDuration:
+		end, err := parseBoundary(second)
+		if err != nil {
+			return Period{}, fmt.Errorf("parse period %q: end: %w", s, err)
+		}
+		if end.IsZero() {
+			return Period{}, fmt.Errorf("parse period %q: end of a duration/end interval must not be open", s)
+		}
+
+		d, err := ParseCalendarDuration(first)
+		if err != nil {
+			return Period{}, fmt.Errorf("parse period %q: duration: %w", s, err)
+		}
+
+		return Period{Start: d.Negate().AddTo(end), End: end}, nil
+
+	default:
+		var (
+			out Period
+			err error
+		)
+
+		if out.Start, err = parseBoundary(first); err != nil {
+			return Period{}, fmt.Errorf("parse period %q: start: %w", s, err)
+		}
+
+		if out.End, err = parseBoundary(second); err != nil {
+			return Period{}, fmt.Errorf("parse period %q: end: %w", s, err)
+		}
+
+		return out, nil
+	}
+}
+
+// LocalLayouts are the layouts used by [ParseInLocation] if no layouts are
+// given. They describe wall times without zone information.
+var LocalLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// zonelessLocation is the location that [ParseInLocation] parses values in. A
+// parsed time is only in this location if its value has no zone information,
+// because its odd offset matches neither "Z" nor any real zone offset.
+var zonelessLocation = time.FixedZone("timefn-zoneless", 1)
+
+// ParseInLocation parses a wall time such as "2024-03-31 02:30" in the given
+// location, trying each of the layouts in order until one matches. If no
+// layouts are given, [LocalLayouts] are used. Unlike [time.ParseInLocation],
+// wall times that do not exist or that occur twice in the location are
+// resolved using the [GapPolicy] and [AmbiguityPolicy] configured by [OnGap]
+// and [OnAmbiguity]. If a policy rejects the wall time, the returned error
+// wraps [ErrNonexistentTime] or [ErrAmbiguousTime] and describes the
+// transition. Values that include a zone offset are unambiguous and are only
+// converted to the location.
+func ParseInLocation(layouts []string, value string, loc *time.Location, opts ...WallClockOption) (time.Time, error) {
+	if len(layouts) == 0 {
+		layouts = LocalLayouts
+	}
+
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, value, zonelessLocation); err != nil {
+			continue
+		}
+
+		if t.Location() != zonelessLocation {
+			return t.In(loc), nil
+		}
+
+		resolved, err := resolveWallClock(wallClock(t), loc, newWallClockConfig(opts))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parse %q in %s: %w", value, loc, err)
+		}
+
+		return resolved, nil
+	}
+
+	return time.Time{}, fmt.Errorf("parse %q in %s: no layout matches: %w", value, loc, err)
+}
@@ -0,0 +1,156 @@
+package timefn_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestParsePeriod(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan31 := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+	feb1 := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input   string
+		want    timefn.Period
+		wantErr bool
+	}{
+		{input: "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z", want: timefn.Period{Start: jan1, End: feb1}},
+		{input: "2023-01-01T00:00:00Z/P1M", want: timefn.Period{Start: jan1, End: feb1}},
+		{input: "2023-01-01T00:00:00Z/P1MT1H", want: timefn.Period{Start: jan1, End: feb1.Add(time.Hour)}},
+		{input: "2023-01-01T00:00:00Z/PT1.5H", want: timefn.Period{Start: jan1, End: jan1.Add(90 * time.Minute)}},
+		{input: "2023-01-01T00:00:00Z/P1Y2M3DT4H5M6S", want: timefn.Period{Start: jan1, End: time.Date(2024, time.March, 4, 4, 5, 6, 0, time.UTC)}},
+		{input: "P2W/2023-02-01T00:00:00Z", want: timefn.Period{Start: feb1.AddDate(0, 0, -14), End: feb1}},
+		{input: "P1D/2023-02-01T00:00:00Z", want: timefn.Period{Start: jan31, End: feb1}},
+		{input: "2023-01-31T00:00:00Z/P1M", want: timefn.Period{Start: jan31, End: time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC)}},
+		{input: "P1M/2023-03-31T00:00:00Z", want: timefn.Period{Start: time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC), End: time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC)}},
+		{input: "P1Y/2025-02-28T00:00:00Z", want: timefn.Period{Start: time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC), End: time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)}},
+		{input: "2024-02-29T00:00:00Z/P1Y", want: timefn.Period{Start: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), End: time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)}},
+		{input: "2023-01-01T00:00:00Z/..", want: timefn.Period{Start: jan1}},
+		{input: "2023-01-01T00:00:00Z", wantErr: true},
+		{input: "P1D/P1D", wantErr: true},
+		{input: "../P1D", wantErr: true},
+		{input: "2023-01-01T00:00:00Z/P", wantErr: true},
+		{input: "2023-01-01T00:00:00Z/PT", wantErr: true},
+		{input: "2023-01-01T00:00:00Z/P1.5D", wantErr: true},
+		{input: "2023-01-01T00:00:00Z/P1X", wantErr: true},
+		{input: "2023-01-01T00:00:00Z/PT1.5H30M", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := timefn.ParsePeriod(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParsePeriod(%q) should fail; got %v", tt.input, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParsePeriod(%q) failed: %v", tt.input, err)
+			}
+
+			if !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) {
+				t.Errorf("ParsePeriod(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInLocation(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		layouts []string
+		value   string
+		opts    []timefn.WallClockOption
+		want    time.Time
+		wantErr error
+	}{
+		{
+			name:  "default layouts",
+			value: "2024-03-30 02:30",
+			want:  time.Date(2024, time.March, 30, 2, 30, 0, 0, berlin),
+		},
+		{
+			name:  "date only",
+			value: "2024-03-30",
+			want:  time.Date(2024, time.March, 30, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:    "custom layout",
+			layouts: []string{"02.01.2006 15:04"},
+			value:   "30.03.2024 02:30",
+			want:    time.Date(2024, time.March, 30, 2, 30, 0, 0, berlin),
+		},
+		{
+			name:  "nonexistent time, shifted forward",
+			value: "2024-03-31 02:30",
+			want:  time.Date(2024, time.March, 31, 3, 30, 0, 0, berlin),
+		},
+		{
+			name:    "nonexistent time, rejected",
+			value:   "2024-03-31 02:30",
+			opts:    []timefn.WallClockOption{timefn.OnGap(timefn.GapReject)},
+			wantErr: timefn.ErrNonexistentTime,
+		},
+		{
+			name:    "ambiguous time, rejected",
+			value:   "2024-10-27 02:30",
+			opts:    []timefn.WallClockOption{timefn.OnAmbiguity(timefn.AmbiguityReject)},
+			wantErr: timefn.ErrAmbiguousTime,
+		},
+		{
+			name:    "explicit offset",
+			layouts: []string{time.RFC3339},
+			value:   "2024-03-31T02:30:00+05:00",
+			want:    time.Date(2024, time.March, 30, 21, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "explicit UTC zone",
+			layouts: []string{time.RFC3339},
+			value:   "2024-03-31T02:30:00Z",
+			want:    time.Date(2024, time.March, 31, 2, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "explicit zero offset",
+			layouts: []string{"2006-01-02T15:04:05-07:00"},
+			value:   "2024-03-31T02:30:00+00:00",
+			want:    time.Date(2024, time.March, 31, 2, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timefn.ParseInLocation(tt.layouts, tt.value, berlin, tt.opts...)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected error %v; got %v", tt.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseInLocation() failed: %v", err)
+			}
+
+			if !got.Equal(tt.want) || got.Location() != berlin {
+				t.Errorf("ParseInLocation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := timefn.ParseInLocation(nil, "foo", berlin); err == nil {
+		t.Errorf("ParseInLocation() should fail if no layout matches")
+	}
+}
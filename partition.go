@@ -0,0 +1,69 @@
+package timefn
+
+import (
+	"fmt"
+	"time"
+)
+
+// PartitionScheme describes how time-partitioned storage, such as database
+// tables or object store prefixes, is named. Each partition covers one Unit of
+// time and is named by formatting its start using Layout, e.g. the layout
+// "events_2006_01" with [UnitMonth] results in names like "events_2024_03",
+// and the layout "dt=2006-01-02" with [UnitDay] in names like
+// "dt=2024-03-05". Partitions are computed in Location, or in UTC if Location
+// is nil.
+type PartitionScheme struct {
+	Unit     Unit
+	Layout   string
+	Location *time.Location
+}
+
+func (s PartitionScheme) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+// PartitionFor returns the name of the partition that contains t.
+func PartitionFor(t time.Time, scheme PartitionScheme) string {
+	return scheme.Unit.Start(t.In(scheme.location())).Format(scheme.Layout)
+}
+
+// PartitionsFor returns the names of all partitions that overlap with the
+// given period, in chronological order. It returns nil if p is not valid or if
+// the unit of the scheme is unknown.
+func PartitionsFor(p Period, scheme PartitionScheme) []string {
+	if p.Validate() != nil || scheme.Unit.approx() == 0 {
+		return nil
+	}
+
+	var out []string
+	for current := scheme.Unit.Start(p.Start.In(scheme.location())); current.Before(p.End); {
+		out = append(out, current.Format(scheme.Layout))
+		current = scheme.Unit.Period(current).End
+	}
+
+	return out
+}
+
+// ParsePartition returns the period covered by the partition with the given
+// name. It returns an error if the unit of the scheme is unknown, or if the
+// name does not match the layout of the scheme or does not denote the start of
+// a partition.
+func ParsePartition(name string, scheme PartitionScheme) (Period, error) {
+	if scheme.Unit.approx() == 0 {
+		return Period{}, fmt.Errorf("parse partition %q: unknown unit %d", name, int(scheme.Unit))
+	}
+
+	start, err := time.ParseInLocation(scheme.Layout, name, scheme.location())
+	if err != nil {
+		return Period{}, fmt.Errorf("parse partition %q: %w", name, err)
+	}
+
+	if !scheme.Unit.Start(start).Equal(start) {
+		return Period{}, fmt.Errorf("partition %q does not start at a %s boundary", name, scheme.Unit)
+	}
+
+	return scheme.Unit.Period(start), nil
+}
@@ -0,0 +1,89 @@
+package timefn_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+var (
+	monthlyTables = timefn.PartitionScheme{Unit: timefn.UnitMonth, Layout: "events_2006_01"}
+	dailyPrefixes = timefn.PartitionScheme{Unit: timefn.UnitDay, Layout: "dt=2006-01-02"}
+)
+
+func TestPartitionFor(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	tm := time.Date(2024, time.March, 5, 3, 0, 0, 0, tokyo)
+
+	if got := timefn.PartitionFor(tm, monthlyTables); got != "events_2024_03" {
+		t.Errorf("PartitionFor() = %q, want %q", got, "events_2024_03")
+	}
+
+	// 03:00 in Tokyo is still March 4 in UTC.
+	if got := timefn.PartitionFor(tm, dailyPrefixes); got != "dt=2024-03-04" {
+		t.Errorf("PartitionFor() = %q, want %q", got, "dt=2024-03-04")
+	}
+
+	local := dailyPrefixes
+	local.Location = tokyo
+	if got := timefn.PartitionFor(tm, local); got != "dt=2024-03-05" {
+		t.Errorf("PartitionFor() = %q, want %q", got, "dt=2024-03-05")
+	}
+}
+
+func TestPartitionsFor(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	want := []string{"events_2024_01", "events_2024_02", "events_2024_03"}
+	if got := timefn.PartitionsFor(p, monthlyTables); !reflect.DeepEqual(got, want) {
+		t.Errorf("PartitionsFor() = %v, want %v", got, want)
+	}
+}
+
+func TestPartitionsFor_unknownUnit(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, unit := range []timefn.Unit{0, timefn.UnitYear + 1} {
+		scheme := timefn.PartitionScheme{Unit: unit, Layout: "events_2006_01"}
+
+		if got := timefn.PartitionsFor(p, scheme); got != nil {
+			t.Errorf("PartitionsFor() with unit %d = %v, want nil", int(unit), got)
+		}
+
+		if _, err := timefn.ParsePartition("events_2024_02", scheme); err == nil {
+			t.Errorf("ParsePartition() with unit %d should fail", int(unit))
+		}
+	}
+}
+
+func TestParsePartition(t *testing.T) {
+	p, err := timefn.ParsePartition("events_2024_02", monthlyTables)
+	if err != nil {
+		t.Fatalf("ParsePartition() failed: %v", err)
+	}
+
+	want := timefn.Period{
+		Start: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if p != want {
+		t.Errorf("ParsePartition() = %v, want %v", p, want)
+	}
+
+	if _, err := timefn.ParsePartition("events_2024", monthlyTables); err == nil {
+		t.Errorf("expected ParsePartition() to fail for malformed name")
+	}
+
+	weekly := timefn.PartitionScheme{Unit: timefn.UnitWeek, Layout: "week_2006_01_02"}
+	if _, err := timefn.ParsePartition("week_2024_03_06", weekly); err == nil {
+		t.Errorf("expected ParsePartition() to fail for a name that is not at a week boundary")
+	}
+}
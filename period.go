@@ -1,11 +1,12 @@
 package timefn
 
 import (
+	"context"
 	"fmt"
 	"math"
+	"math/big"
+	"math/rand"
 	"slices"
-	"sort"
-	"strings"
 	"text/template"
 	"time"
 
@@ -31,6 +32,13 @@ var DefaultPeriodFormat = "{{ .Start }} -> {{ .End }}"
 //
 // Additionally, it provides functionalities to cut out certain periods from
 // itself and return the remaining periods.
+//
+// A period with a zero End and a non-zero Start is open-ended and extends
+// indefinitely into the future. Likewise, a period with a zero Start and a
+// non-zero End extends indefinitely into the past. Contains, OverlapsWith, Cut,
+// Merge and Format honor open boundaries; Validate rejects them unless
+// explicitly allowed. A period where both Start and End are zero is the empty
+// period, see [Period.IsZero].
 type Period struct {
 	Start time.Time `json:"start"`
 	End   time.Time `json:"end"`
@@ -54,24 +62,31 @@ func (p Period) Format() string {
 // FormatAs formats the period using the given format string. The format string
 // can contain placeholders for the start and end times of the period. If an
 // empty string is passed as the format, the default format "{{ .Start }} -> {{
-// .End }}" is used. If an error occurs during formatting, it returns a string
-// representation of the error message.
+// .End }}" is used. .Start and .End are the [time.Time] boundaries of the
+// period, and the methods of the period, such as {{ .Duration }} and
+// {{ .Years }}, are available. The following functions are available within
+// the format:
+//
+//	{{ format .Start "2006-01-02" }}             formats a boundary using a layout
+//	{{ inLocation .End "Europe/Berlin" }}        converts a boundary to a location
+//	{{ utc .Start }}                             converts a boundary to UTC
+//	{{ duration . }}                             the duration as by [FormatDuration]
+//	{{ formatLocale .Start "de" "2. January" }}  formats a boundary in a locale
+//
+// Open boundaries are printed as "-inf" and "+inf", also by format, and the
+// duration function prints "inf" for open periods. If an error occurs during
+// formatting, it returns a string representation of the error message.
 func (p Period) FormatAs(format string) string {
 	if format == "" {
 		format = "{{ .Start }} -> {{ .End }}"
 	}
 
-	var buf strings.Builder
-	tpl, err := template.New("").Parse(format)
+	tpl, err := template.New("").Funcs(periodFuncs).Parse(format)
 	if err != nil {
 		return fmt.Sprintf("<failed to format period: %s>", err)
 	}
 
-	if err := tpl.Execute(&buf, p); err != nil {
-		return fmt.Sprintf("<failed to format period: %s>", err)
-	}
-
-	return buf.String()
+	return p.execute(tpl)
 }
 
 // IsZero checks if the start and end times of the period are both zero,
@@ -81,11 +96,80 @@ func (p Period) IsZero() bool {
 	return p.Start.IsZero() && p.End.IsZero()
 }
 
+// OpenStart reports whether the period has no start boundary, which is the case
+// if Start is zero while End is not. An open start extends the period
+// indefinitely into the past.
+func (p Period) OpenStart() bool {
+	return p.Start.IsZero() && !p.End.IsZero()
+}
+
+// OpenEnd reports whether the period has no end boundary, which is the case if
+// End is zero while Start is not. An open end extends the period indefinitely
+// into the future.
+func (p Period) OpenEnd() bool {
+	return p.End.IsZero() && !p.Start.IsZero()
+}
+
+// unbounded reports whether the period has neither a start nor an end
+// boundary. This is the case for the zero Period and for the far boundaries
+// that [Merge] keeps for periods that are open at both ends, see
+// [Period.reopened].
+func (p Period) unbounded() bool {
+	return p.IsZero() || (p.Start.Equal(farPast) && p.End.Equal(farFuture))
+}
+
+// bounds returns the effective start and end of the period, replacing open
+// boundaries with times that lie far in the past or future.
+func (p Period) bounds() (time.Time, time.Time) {
+	start, end := p.Start, p.End
+	if p.OpenStart() {
+		start = farPast
+	}
+	if p.OpenEnd() {
+		end = farFuture
+	}
+	return start, end
+}
+
+// ValidateOption is an option for [Period.Validate].
+type ValidateOption func(*validateConfig)
+
+type validateConfig struct {
+	openStart bool
+	openEnd   bool
+}
+
+// AllowOpenStart returns a [ValidateOption] that makes [Period.Validate] accept
+// periods with an open start, i.e. a zero Start and a non-zero End.
+func AllowOpenStart() ValidateOption {
+	return func(cfg *validateConfig) {
+		cfg.openStart = true
+	}
+}
+
+// AllowOpenEnd returns a [ValidateOption] that makes [Period.Validate] accept
+// periods with an open end, i.e. a non-zero Start and a zero End.
+func AllowOpenEnd() ValidateOption {
+	return func(cfg *validateConfig) {
+		cfg.openEnd = true
+	}
+}
+
 // Validate checks the validity of the [Period]. It returns an error if the
 // Start time is zero, the End time is zero, or if the End time is equal to or
 // before the Start time. If none of these conditions are met, it returns nil
-// indicating that the [Period] is valid.
-func (p Period) Validate() error {
+// indicating that the [Period] is valid. Open-ended periods can be allowed
+// using [AllowOpenStart] and [AllowOpenEnd]; the empty period is never valid.
+func (p Period) Validate(opts ...ValidateOption) error {
+	var cfg validateConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if (cfg.openStart && p.OpenStart()) || (cfg.openEnd && p.OpenEnd()) {
+		return nil
+	}
+
 	if p.Start.IsZero() {
 		return fmt.Errorf("start is zero")
 	}
@@ -105,6 +189,57 @@ func (p Period) Validate() error {
 	return nil
 }
 
+// Progress returns the fraction of the period that has elapsed at time t,
+// clamped to the range [0, 1]. It returns 0 if t is at or before the start and
+// 1 if t is at or after the end. A zero-length period jumps from 0 to 1 at its
+// start. Inverted periods and periods with a zero boundary have no meaningful
+// progress and always report 0.
+func (p Period) Progress(t time.Time) float64 {
+	if p.Start.IsZero() || p.End.IsZero() || p.End.Before(p.Start) {
+		return 0
+	}
+
+	if p.End.Equal(p.Start) {
+		if t.Before(p.Start) {
+			return 0
+		}
+		return 1
+	}
+
+	return float64(p.Elapsed(t)) / float64(p.Duration())
+}
+
+// Normalize returns the period with Start and End swapped if End is before
+// Start, so that the returned period always runs forward in time. If either
+// Start or End is zero, the period is returned unchanged, because a zero
+// boundary carries no ordering information.
+func (p Period) Normalize() Period {
+	if p.Start.IsZero() || p.End.IsZero() {
+		return p
+	}
+
+	if p.End.Before(p.Start) {
+		p.Start, p.End = p.End, p.Start
+	}
+
+	return p
+}
+
+// EqualWithin reports whether p and p2 describe the same period, allowing
+// each boundary to differ by at most the given tolerance. This is useful to
+// compare periods from systems that store times with different precision. Zero
+// boundaries only equal other zero boundaries.
+func (p Period) EqualWithin(p2 Period, tolerance time.Duration) bool {
+	return equalWithin(p.Start, p2.Start, tolerance) && equalWithin(p.End, p2.End, tolerance)
+}
+
+func equalWithin(a, b time.Time, tolerance time.Duration) bool {
+	if a.IsZero() || b.IsZero() {
+		return a.IsZero() && b.IsZero()
+	}
+	return absoluteStep(a.Sub(b)) <= absoluteStep(tolerance)
+}
+
 // Add extends the start and end times of the period by a specified duration. It
 // returns a new Period with the updated start and end times.
 func (p Period) Add(d time.Duration) Period {
@@ -114,18 +249,207 @@ func (p Period) Add(d time.Duration) Period {
 	}
 }
 
+// AddDate returns the period with [time.Time.AddDate] applied to both Start
+// and End. Unlike [Period.Add], the boundaries keep their wall-clock times
+// across DST transitions and months of different lengths. Open boundaries stay
+// open.
+func (p Period) AddDate(years, months, days int) Period {
+	out := p
+	if !p.Start.IsZero() {
+		out.Start = p.Start.AddDate(years, months, days)
+	}
+	if !p.End.IsZero() {
+		out.End = p.End.AddDate(years, months, days)
+	}
+	return out
+}
+
+// Buffer returns the period with Start moved earlier by before and End moved
+// later by after. Negative margins shrink the period instead. Open boundaries
+// stay open. If the margins shrink the period past itself, Buffer returns a
+// zero-length period at the midpoint between the shrunk boundaries.
+func (p Period) Buffer(before, after time.Duration) Period {
+	out := p
+	if !p.Start.IsZero() {
+		out.Start = p.Start.Add(-before)
+	}
+	if !p.End.IsZero() {
+		out.End = p.End.Add(after)
+	}
+
+	if !out.Start.IsZero() && !out.End.IsZero() && out.End.Before(out.Start) {
+		mid := out.End.Add(out.Start.Sub(out.End) / 2)
+		out.Start, out.End = mid, mid
+	}
+
+	return out
+}
+
+// In returns the period with both Start and End converted to the given
+// location. The instants of the period are unchanged. Zero boundaries are left
+// untouched. In panics if loc is nil.
+func (p Period) In(loc *time.Location) Period {
+	if loc == nil {
+		panic("timefn: nil location in Period.In")
+	}
+	if !p.Start.IsZero() {
+		p.Start = p.Start.In(loc)
+	}
+	if !p.End.IsZero() {
+		p.End = p.End.In(loc)
+	}
+	return p
+}
+
+// UTC returns the period with both Start and End converted to UTC.
+func (p Period) UTC() Period {
+	return p.In(time.UTC)
+}
+
+// Duration returns the length of the period, which is the time between its
+// start and end. The result is negative if the end is before the start.
+func (p Period) Duration() time.Duration {
+	return p.End.Sub(p.Start)
+}
+
+// Elapsed returns how much of the period has passed at the given time. The
+// result is clamped to the range [0, p.Duration()], so it is 0 before the
+// period starts and the full duration after it ends. Inverted periods always
+// report 0.
+func (p Period) Elapsed(at time.Time) time.Duration {
+	total := p.Duration()
+	if total <= 0 {
+		return 0
+	}
+
+	elapsed := at.Sub(p.Start)
+	if elapsed < 0 {
+		return 0
+	}
+	if elapsed > total {
+		return total
+	}
+
+	return elapsed
+}
+
+// Remaining returns how much of the period is left at the given time. The
+// result is clamped to the range [0, p.Duration()], so it is the full duration
+// before the period starts and 0 after it ends. Inverted periods always report
+// 0.
+func (p Period) Remaining(at time.Time) time.Duration {
+	total := p.Duration()
+	if total <= 0 {
+		return 0
+	}
+	return total - p.Elapsed(at)
+}
+
+// Midpoint returns the instant halfway between the start and end of the
+// period.
+func (p Period) Midpoint() time.Time {
+	return p.Start.Add(p.Duration() / 2)
+}
+
+// Random returns a uniformly distributed random instant within the period,
+// which may be the start but never the end of the period. If r is nil, the
+// top-level functions of the math/rand package are used. Periods with a
+// duration of zero or less always return their start.
+func (p Period) Random(r *rand.Rand) time.Time {
+	d := p.Duration()
+	if d <= 0 {
+		return p.Start
+	}
+
+	if r == nil {
+		return p.Start.Add(time.Duration(rand.Int63n(int64(d))))
+	}
+
+	return p.Start.Add(time.Duration(r.Int63n(int64(d))))
+}
+
 // Contains checks whether a given time falls within the period. It returns true
 // if the time is the same as or after the start of the period, and before the
-// end of the period.
+// end of the period. Open boundaries contain all times on their side.
 func (p Period) Contains(t time.Time) bool {
-	return SameOrBefore(p.Start, t) && p.End.After(t)
+	start, end := p.bounds()
+	return SameOrBefore(start, t) && end.After(t)
 }
 
 // ContainsInclusive checks if a given time is within the period, including the
 // start and end times. It returns true if the time falls within or exactly on
-// the start and end times of the period, otherwise it returns false.
+// the start and end times of the period, otherwise it returns false. Open
+// boundaries contain all times on their side.
 func (p Period) ContainsInclusive(t time.Time) bool {
-	return SameOrBefore(p.Start, t) && SameOrAfter(p.End, t)
+	start, end := p.bounds()
+	return SameOrBefore(start, t) && SameOrAfter(end, t)
+}
+
+// IsActive reports whether the current time, as returned by [Now], falls within
+// the period. The start of the period is inclusive and the end is exclusive, as
+// with [Period.Contains].
+func (p Period) IsActive() bool {
+	return p.Contains(Now())
+}
+
+// IsActiveContext is like [Period.IsActive], but reads the current time from
+// [NowFromContext].
+func (p Period) IsActiveContext(ctx context.Context) bool {
+	return p.Contains(NowFromContext(ctx))
+}
+
+// IsOver reports whether the period has ended, which is the case if its end is
+// at or before the current time, as returned by [Now]. A period with an open
+// end is never over.
+func (p Period) IsOver() bool {
+	return p.isOver(Now())
+}
+
+// IsOverContext is like [Period.IsOver], but reads the current time from
+// [NowFromContext].
+func (p Period) IsOverContext(ctx context.Context) bool {
+	return p.isOver(NowFromContext(ctx))
+}
+
+func (p Period) isOver(now time.Time) bool {
+	if p.OpenEnd() {
+		return false
+	}
+	return SameOrBefore(p.End, now)
+}
+
+// StartsIn returns the duration from the current time, as returned by [Now],
+// until the start of the period. The result is negative if the period has
+// already started.
+func (p Period) StartsIn() time.Duration {
+	return TimeUntil(p.Start)
+}
+
+// StartsInContext is like [Period.StartsIn], but reads the current time from
+// [NowFromContext].
+func (p Period) StartsInContext(ctx context.Context) time.Duration {
+	return TimeUntilContext(ctx, p.Start)
+}
+
+// EndsIn returns the duration from the current time, as returned by [Now],
+// until the end of the period. The result is negative if the period has
+// already ended. A period with an open end never ends, so EndsIn returns the
+// maximum [time.Duration] for it.
+func (p Period) EndsIn() time.Duration {
+	return p.endsIn(Now())
+}
+
+// EndsInContext is like [Period.EndsIn], but reads the current time from
+// [NowFromContext].
+func (p Period) EndsInContext(ctx context.Context) time.Duration {
+	return p.endsIn(NowFromContext(ctx))
+}
+
+func (p Period) endsIn(now time.Time) time.Duration {
+	if p.OpenEnd() {
+		return math.MaxInt64
+	}
+	return p.End.Sub(now)
 }
 
 // OverlapsWith returns whether p and p2 overlap.
@@ -149,13 +473,27 @@ func (p Period) OverlapsWithStep(step time.Duration, p2 Period) bool {
 	}
 
 	step = absoluteStep(step)
-	pEnd := p.End.Add(-step)
-	p2End := p2.End.Add(-step)
 
-	return BetweenInclusive(p.Start, p2.Start, p2End) ||
-		BetweenInclusive(pEnd, p2.Start, p2End) ||
-		BetweenInclusive(p2.Start, p.Start, pEnd) ||
-		BetweenInclusive(p2End, p.Start, pEnd)
+	if pStart, pEnd, ok := p.utcNanos(); ok && step < fastPathLimit {
+		if p2Start, p2End, ok := p2.utcNanos(); ok {
+			pEnd -= int64(step)
+			p2End -= int64(step)
+			return betweenNanos(pStart, p2Start, p2End) ||
+				betweenNanos(pEnd, p2Start, p2End) ||
+				betweenNanos(p2Start, pStart, pEnd) ||
+				betweenNanos(p2End, pStart, pEnd)
+		}
+	}
+
+	pStart, pEnd := p.bounds()
+	p2Start, p2End := p2.bounds()
+	pEnd = pEnd.Add(-step)
+	p2End = p2End.Add(-step)
+
+	return BetweenInclusive(pStart, p2Start, p2End) ||
+		BetweenInclusive(pEnd, p2Start, p2End) ||
+		BetweenInclusive(p2Start, pStart, pEnd) ||
+		BetweenInclusive(p2End, pStart, pEnd)
 }
 
 // Years returns a slice of integers representing the years that fall within the
@@ -216,6 +554,73 @@ func (p Period) InYearStep(step time.Duration, year int) bool {
 	return slices.Contains(p.YearsStep(step), year)
 }
 
+// Months returns the calendar months that fall within the period, in
+// chronological order. A month is included in the result if any part of that
+// month is within the period.
+func (p Period) Months() []Month {
+	return p.MonthsStep(time.Nanosecond)
+}
+
+// MonthsStep returns the calendar months of the period. The step defines the
+// minimum duration the period must be in a month for it to be included in the
+// result, using the same semantics as [Period.YearsStep]. A step of 1
+// nanosecond would consider the following period to be only in January 2020:
+//
+//	"2020-01-01 00:00:00 -> 2020-02-01 00:00:00"
+func (p Period) MonthsStep(step time.Duration) []Month {
+	step = absoluteStep(step)
+	min := monthIndex(MonthOf(p.Start))
+	max := monthIndex(MonthOf(p.End.Add(-step)))
+
+	if min > max {
+		min, max = max, min
+	}
+
+	out := make([]Month, (max-min)+1)
+	for i := range out {
+		idx := min + i
+		out[i] = Month{Year: floorDiv(idx, 12), Month: time.Month(floorMod(idx, 12) + 1)}
+	}
+
+	return out
+}
+
+// Quarters returns the calendar quarters that fall within the period, in
+// chronological order. A quarter is included in the result if any part of that
+// quarter is within the period.
+func (p Period) Quarters() []Quarter {
+	return p.QuartersStep(time.Nanosecond)
+}
+
+// QuartersStep returns the calendar quarters of the period. The step defines
+// the minimum duration the period must be in a quarter for it to be included in
+// the result, using the same semantics as [Period.YearsStep].
+func (p Period) QuartersStep(step time.Duration) []Quarter {
+	step = absoluteStep(step)
+	min := quarterIndex(QuarterOf(p.Start))
+	max := quarterIndex(QuarterOf(p.End.Add(-step)))
+
+	if min > max {
+		min, max = max, min
+	}
+
+	out := make([]Quarter, (max-min)+1)
+	for i := range out {
+		idx := min + i
+		out[i] = Quarter{Year: floorDiv(idx, 4), Quarter: floorMod(idx, 4) + 1}
+	}
+
+	return out
+}
+
+func monthIndex(m Month) int {
+	return m.Year*12 + int(m.Month) - 1
+}
+
+func quarterIndex(q Quarter) int {
+	return q.Year*4 + q.Quarter - 1
+}
+
 // Dates retrieves all the dates within the period, returning a slice of
 // [time.Time]. Each date is represented by the start of the day, and they are
 // returned in chronological order from the start to the end of the period. If
@@ -306,6 +711,67 @@ func (p Period) SliceDatesStep(step time.Duration, fn func(date time.Time, i int
 	return
 }
 
+// SplitWeighted splits the period into contiguous sub-periods whose durations
+// are proportional to the given weights. The i-th sub-period corresponds to the
+// i-th weight; a weight of 0 results in a zero-length sub-period. Boundaries
+// are computed from the cumulative weights using exact rational arithmetic and
+// rounded to the nearest nanosecond, so the result is deterministic, has no
+// gaps and ends exactly at the end of the period, even for periods that are
+// too long to be represented exactly as a float64 number of nanoseconds.
+// SplitWeighted returns nil if the period is invalid, if any weight is
+// negative, NaN or infinite, or if the weights sum up to 0.
+func (p Period) SplitWeighted(weights []float64) []Period {
+	if p.Validate() != nil || len(weights) == 0 {
+		return nil
+	}
+
+	exact := make([]*big.Rat, len(weights))
+	sum := new(big.Rat)
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil
+		}
+		exact[i] = new(big.Rat).SetFloat64(w)
+		sum.Add(sum, exact[i])
+	}
+
+	if sum.Sign() == 0 {
+		return nil
+	}
+
+	// scale converts cumulative weights into nanoseconds since the start.
+	scale := new(big.Rat).SetFrac(big.NewInt(int64(p.Duration())), big.NewInt(1))
+	scale.Quo(scale, sum)
+
+	out := make([]Period, len(weights))
+	start := p.Start
+
+	cum := new(big.Rat)
+	offset := new(big.Rat)
+	for i, w := range exact {
+		cum.Add(cum, w)
+
+		end := p.End
+		if i < len(weights)-1 {
+			end = p.Start.Add(time.Duration(roundRat(offset.Mul(cum, scale))))
+		}
+
+		out[i] = Period{Start: start, End: end}
+		start = end
+	}
+
+	return out
+}
+
+// roundRat rounds a non-negative rational number to the nearest integer,
+// rounding halves up.
+func roundRat(r *big.Rat) int64 {
+	num := new(big.Int).Lsh(r.Num(), 1)
+	num.Add(num, r.Denom())
+	den := new(big.Int).Lsh(r.Denom(), 1)
+	return num.Quo(num, den).Int64()
+}
+
 // Cut removes specified periods from the receiver [Period] and returns a slice
 // of the remaining [Period]s. This operation is non-destructive to the original
 // [Period]. If no periods are specified for removal or if none of the specified
@@ -314,54 +780,199 @@ func (p Period) SliceDatesStep(step time.Duration, fn func(date time.Time, i int
 // of [Period]s that represent the time spans before and after each
 // intersection, effectively "cutting out" the intersecting ranges. The
 // resulting slice is sorted by the start times of each [Period].
+//
+// The periods to cut are merged once and then removed in a single sweep, so
+// Cut runs in O(m log m) time for m periods to cut. The given periods are not
+// modified.
 func (p Period) Cut(cut ...Period) []Period {
-	slices.SortFunc(cut, func(a, b Period) int {
-		if a.Start.Before(b.Start) {
-			return -1
+	if p.IsZero() {
+		return []Period{p}
+	}
+
+	if out, ok := p.cutUTC(cut); ok {
+		return out
+	}
+
+	out, _ := cutSweep(nil, p.closed(), mergedClosed(cut), 0)
+
+	return reopenAll(out)
+}
+
+// utcSpan is a period as Unix nanoseconds, see [Period.utcNanos], together with
+// the indices of the periods that its start and end were taken from. Unlike
+// [Period], it contains no pointers, so sorting spans is cheap.
+type utcSpan struct {
+	start, end       int64
+	startIdx, endIdx int
+}
+
+// cutUTC is the fast path of [Period.Cut] for UTC periods, which merges and
+// sweeps the periods to cut as Unix nanoseconds. It reports false if p or any
+// of the periods to cut does not qualify for the fast path, or if a period to
+// cut is reversed. The result is the same as that of the slow path, including
+// the [time.Time] values of the boundaries.
+func (p Period) cutUTC(cut []Period) ([]Period, bool) {
+	pStart, pEnd, ok := p.utcNanos()
+	if !ok {
+		return nil, false
+	}
+
+	spans := make([]utcSpan, 0, len(cut))
+	for i, c := range cut {
+		if c.IsZero() {
+			continue
 		}
-		if a.Start.After(b.Start) {
-			return 1
+		start, end, ok := c.utcNanos()
+		if !ok || start > end {
+			return nil, false
+		}
+		spans = append(spans, utcSpan{start: start, end: end, startIdx: i, endIdx: i})
+	}
+
+	// Sorting by index last makes the sort behave like the stable sort of
+	// [SortPeriods].
+	slices.SortFunc(spans, func(a, b utcSpan) int {
+		switch {
+		case a.start != b.start:
+			return compareInt64(a.start, b.start)
+		case a.end != b.end:
+			return compareInt64(a.end, b.end)
+		default:
+			return a.startIdx - b.startIdx
 		}
-		return 0
 	})
 
-	remaining := []Period{p}
+	merged := spans[:0]
+	for _, s := range spans {
+		if n := len(merged); n > 0 && s.start <= merged[n-1].end {
+			if last := &merged[n-1]; s.end >= last.end {
+				last.end, last.endIdx = s.end, s.endIdx
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
 
-	for _, c := range cut {
-		newRemaining := make([]Period, 0, len(remaining))
+	// A zero-length period is removed by any period that touches it.
+	if pStart >= pEnd {
+		for _, r := range merged {
+			if r.start > pEnd {
+				break
+			}
+			if r.end >= pStart {
+				return nil, true
+			}
+		}
+		return []Period{p}, true
+	}
+
+	var out []Period
+	current, currentStart := p, pStart
+	for _, r := range merged {
+		if r.end <= currentStart {
+			continue
+		}
+		if r.start >= pEnd {
+			break
+		}
+
+		if r.start > currentStart {
+			out = append(out, Period{Start: current.Start, End: cut[r.startIdx].Start})
+		}
 
-		for _, r := range remaining {
-			if cutted, ok := r.cut(c); ok {
-				newRemaining = append(newRemaining, cutted...)
-				continue
+		if r.end >= pEnd {
+			return out, true
+		}
+
+		current.Start, currentStart = cut[r.endIdx].End, r.end
+	}
+
+	return append(out, current), true
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// cutSweep appends the parts of p that are not covered by remove to out. p and
+// remove must be closed, and remove must be merged, see [mergedClosed]. The
+// sweep starts at index j of
+// remove, and the returned index is the first period of remove that may still
+// overlap with periods that sort after p.
+func cutSweep(out []Period, p Period, remove []Period, j int) ([]Period, int) {
+	current := p
+	currentStart, pEnd := p.bounds()
+
+	// A zero-length period is removed by any period that touches it.
+	if !currentStart.Before(pEnd) {
+		for k := j; k < len(remove); k++ {
+			rStart, rEnd := remove[k].bounds()
+			if rStart.After(pEnd) {
+				break
+			}
+			if SameOrAfter(rEnd, currentStart) {
+				return out, j
 			}
-			newRemaining = append(newRemaining, r)
 		}
+		return append(out, current), j
+	}
 
-		remaining = newRemaining
+	// Skip the periods to remove that end before the current period.
+	for j < len(remove) {
+		_, rEnd := remove[j].bounds()
+		if rEnd.After(currentStart) {
+			break
+		}
+		j++
+	}
+
+	for k := j; k < len(remove); k++ {
+		r := remove[k]
+		rStart, rEnd := r.bounds()
+
+		if !rStart.Before(pEnd) {
+			break
+		}
+
+		if rStart.After(currentStart) {
+			out = append(out, Period{Start: current.Start, End: r.Start})
+		}
+
+		if !rEnd.Before(pEnd) {
+			return out, j
+		}
+
+		current.Start, currentStart = r.End, rEnd
 	}
 
-	return remaining
+	return append(out, current), j
 }
 
 func (p Period) cut(cut Period) ([]Period, bool) {
-	cutStartZero := cut.Start.IsZero()
-	cutEndZero := cut.End.IsZero()
-
-	if !cutStartZero && SameOrBefore(cut.Start, p.Start) && SameOrAfter(cut.End, p.End) {
-		return nil, true
+	if p.IsZero() || cut.IsZero() {
+		return []Period{p}, false
 	}
 
-	if !cutEndZero && SameOrAfter(cut.End, p.End) && SameOrBefore(cut.Start, p.Start) {
+	pStart, pEnd := p.bounds()
+	cutStart, cutEnd := cut.bounds()
+
+	if SameOrBefore(cutStart, pStart) && SameOrAfter(cutEnd, pEnd) {
 		return nil, true
 	}
 
-	if (!cutStartZero && p.End.Before(cut.Start)) || (!cutEndZero && p.Start.After(cut.End)) {
+	if pEnd.Before(cutStart) || pStart.After(cutEnd) {
 		return []Period{p}, false
 	}
 
-	beforeStart := !cutStartZero && p.Start.Before(cut.Start)
-	afterEnd := !cutEndZero && p.End.After(cut.End)
+	beforeStart := pStart.Before(cutStart)
+	afterEnd := pEnd.After(cutEnd)
 
 	if beforeStart && afterEnd {
 		return []Period{
@@ -381,6 +992,154 @@ func (p Period) cut(cut Period) ([]Period, bool) {
 	return []Period{p}, false
 }
 
+// intersection returns the period that is covered by both p and p2. It returns
+// false if the periods do not overlap by at least a nanosecond.
+func (p Period) intersection(p2 Period) (Period, bool) {
+	if p.IsZero() || p2.IsZero() {
+		return Period{}, false
+	}
+
+	pStart, pEnd := p.bounds()
+	p2Start, p2End := p2.bounds()
+
+	out := Period{Start: p.Start, End: p.End}
+	start, end := pStart, pEnd
+
+	if p2Start.After(pStart) {
+		out.Start, start = p2.Start, p2Start
+	}
+
+	if p2End.Before(pEnd) {
+		out.End, end = p2.End, p2End
+	}
+
+	if !start.Before(end) {
+		return Period{}, false
+	}
+
+	return out, true
+}
+
+// Subtract removes all periods in remove from all periods in from and returns
+// the remaining periods. Both slices are merged first, so the result is
+// normalized: it is sorted by [ComparePeriods] and contains no overlapping or
+// adjacent periods. Subtract sorts both slices once and then walks them in a
+// single sweep, so it runs in O((n+m) log(n+m)) time, unlike repeated calls to
+// [Period.Cut]. The input slices are not modified.
+func Subtract(from, remove []Period) []Period {
+	from = mergedClosed(from)
+	remove = mergedClosed(remove)
+
+	var out []Period
+	j := 0
+
+	for _, f := range from {
+		out, j = cutSweep(out, f, remove, j)
+	}
+
+	return reopenAll(out)
+}
+
+// reopenAll reopens the periods in place, see [Period.reopened].
+func reopenAll(periods []Period) []Period {
+	for i, p := range periods {
+		periods[i] = p.reopened()
+	}
+	return periods
+}
+
+// Intersect returns the periods that are covered by both a and b, such as the
+// times at which two calendars are both busy. Both slices are merged first, so
+// the result is sorted by [ComparePeriods] and contains no overlapping
+// periods. Intersect sorts both slices once and then walks them in a single
+// sweep, so it runs in O((n+m) log(n+m)) time. The input slices are not
+// modified.
+func Intersect(a, b []Period) []Period {
+	a = mergedClosed(a)
+	b = mergedClosed(b)
+
+	var out []Period
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+		if p, ok := a[i].intersection(b[j]); ok {
+			out = append(out, p.reopened())
+		}
+
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return out
+}
+
+// Rescale linearly maps the periods from the reference frame from onto the
+// reference frame to. The start of from maps to the start of to, the end of
+// from maps to the end of to, and all other instants are interpolated or
+// extrapolated in between, so periods outside of from are mapped outside of
+// to. This can be used to replay recorded periods at a different speed or
+// within a different window. Open boundaries stay open. Rescale returns nil if
+// either reference frame is invalid.
+func Rescale(periods []Period, from, to Period) []Period {
+	if from.Validate() != nil || to.Validate() != nil {
+		return nil
+	}
+
+	ratio := float64(to.Duration()) / float64(from.Duration())
+	rescale := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return t
+		}
+		return to.Start.Add(time.Duration(math.Round(float64(t.Sub(from.Start)) * ratio)))
+	}
+
+	return slice.Map(periods, func(p Period) Period {
+		return Period{Start: rescale(p.Start), End: rescale(p.End)}
+	})
+}
+
+// TotalDuration returns the total duration covered by the periods. The periods
+// are merged first, so overlapping time is only counted once. Inverted periods
+// are normalized, and empty or open-ended periods are ignored because they do
+// not have a finite duration.
+func TotalDuration(periods []Period) time.Duration {
+	closed := make([]Period, 0, len(periods))
+	for _, p := range periods {
+		p = p.Normalize()
+		if p.Start.IsZero() || p.End.IsZero() {
+			continue
+		}
+		closed = append(closed, p)
+	}
+
+	var total time.Duration
+	for _, p := range Merge(closed, MergeInPlace()) {
+		total += p.Duration()
+	}
+
+	return total
+}
+
+// Coverage reports how much of bounds is covered by the periods. It returns the
+// covered duration and its ratio to the duration of bounds, ranging from 0 to
+// 1. The periods are merged and clipped to bounds first, so overlapping
+// periods and periods outside of bounds do not inflate the result. Coverage
+// returns 0 for both values if bounds is invalid.
+func Coverage(bounds Period, periods []Period) (covered time.Duration, ratio float64) {
+	if bounds.Validate() != nil {
+		return 0, 0
+	}
+
+	for _, p := range Intersect([]Period{bounds}, periods) {
+		covered += p.Duration()
+	}
+
+	return covered, float64(covered) / float64(bounds.Duration())
+}
+
 // CutInclusive trims the specified periods from the receiver [Period] and
 // returns a slice of [Period]s that represent the remaining time ranges. It
 // does so in an inclusive manner, where the end times of both the receiver and
@@ -390,9 +1149,7 @@ func (p Period) cut(cut Period) ([]Period, bool) {
 // no overlap exists, the original [Period] is returned unchanged. The resulting
 // slice of [Period]s is sorted by their start times.
 func (p Period) CutInclusive(cut ...Period) []Period {
-	periodEndZero := p.End.IsZero()
-
-	if !periodEndZero {
+	if !p.End.IsZero() {
 		p.End = p.End.Add(time.Nanosecond)
 	}
 
@@ -403,16 +1160,215 @@ func (p Period) CutInclusive(cut ...Period) []Period {
 		return p
 	})
 
-	result := p.Cut(cut...)
-
-	if !periodEndZero {
-		result = slice.Map(result, func(p Period) Period {
+	return slice.Map(p.Cut(cut...), func(p Period) Period {
+		if !p.End.IsZero() {
 			p.End = p.End.Add(-time.Nanosecond)
-			return p
-		})
+		}
+		return p
+	})
+}
+
+// Nearest returns the period that is closest to t, together with the distance
+// between t and that period. The distance is zero if the period contains t or
+// if t is the end of the period. If multiple periods are equally close, the
+// first one is returned. Empty periods are ignored; if no other periods are
+// given, Nearest returns false.
+func Nearest(periods []Period, t time.Time) (Period, time.Duration, bool) {
+	var (
+		nearest Period
+		dist    time.Duration
+		found   bool
+	)
+
+	for _, p := range periods {
+		if p.IsZero() {
+			continue
+		}
+
+		d := p.distanceTo(t)
+		if !found || d < dist {
+			nearest, dist, found = p, d, true
+		}
 	}
 
-	return result
+	return nearest, dist, found
+}
+
+// Distance returns the length of the gap between two periods. It is zero if
+// the periods overlap or if one period ends where the other starts. Distance
+// is symmetric, so Distance(a, b) equals Distance(b, a). Open boundaries
+// extend a period indefinitely, so the distance to such a period is only
+// non-zero on its bounded side.
+func Distance(a, b Period) time.Duration {
+	aStart, aEnd := a.bounds()
+	bStart, bEnd := b.bounds()
+
+	if aEnd.Before(bStart) {
+		return bStart.Sub(aEnd)
+	}
+
+	if bEnd.Before(aStart) {
+		return aStart.Sub(bEnd)
+	}
+
+	return 0
+}
+
+// distanceTo returns the distance between the period and t, which is zero if
+// the period contains t.
+func (p Period) distanceTo(t time.Time) time.Duration {
+	start, end := p.bounds()
+
+	if t.Before(start) {
+		return start.Sub(t)
+	}
+
+	if t.After(end) {
+		return t.Sub(end)
+	}
+
+	return 0
+}
+
+// ComparePeriods compares two periods by their start and then by their end. It
+// returns -1 if a sorts before b, 1 if a sorts after b and 0 otherwise. An open
+// start sorts before any other start, and an open end sorts after any other
+// end. ComparePeriods is the ordering used throughout this package and can be
+// passed to [slices.SortFunc].
+func ComparePeriods(a, b Period) int {
+	aStart, aEnd := a.bounds()
+	bStart, bEnd := b.bounds()
+
+	if c := aStart.Compare(bStart); c != 0 {
+		return c
+	}
+
+	return aEnd.Compare(bEnd)
+}
+
+// SortPeriods sorts the periods in place, using [ComparePeriods]. The sort is
+// stable, so equal periods keep their relative order.
+func SortPeriods(periods []Period) {
+	slices.SortStableFunc(periods, ComparePeriods)
+}
+
+// MergeOption is an option for [Merge].
+type MergeOption func(*mergeConfig)
+
+type mergeConfig struct {
+	step    time.Duration
+	inPlace bool
+}
+
+// MergeWithStep returns a [MergeOption] that sets the minimum duration two
+// periods must overlap to be merged. The semantics of the step are the same as
+// for [Period.OverlapsWithStep]. The default step is 0, which also merges
+// adjacent periods.
+func MergeWithStep(step time.Duration) MergeOption {
+	return func(cfg *mergeConfig) {
+		cfg.step = step
+	}
+}
+
+// MergeInPlace returns a [MergeOption] that makes [Merge] sort and merge the
+// given slice in place instead of working on a copy. The returned slice then
+// shares its backing array with the input, whose contents are overwritten.
+// This avoids allocations when the input is no longer needed.
+func MergeInPlace() MergeOption {
+	return func(cfg *mergeConfig) {
+		cfg.inPlace = true
+	}
+}
+
+// Merge merges overlapping periods into continuous periods and returns the
+// result sorted by [ComparePeriods]. Periods that overlap by at least the step
+// configured by [MergeWithStep] are merged; by default, adjacent periods are
+// merged as well. Zero periods are dropped, while instants whose start equals
+// their end are kept or merged like any other period.
+//
+// With a positive step, periods that overlap by less than the step are not
+// merged but kept as separate periods, so the result may contain overlapping
+// periods. [MergePeriodsStep] used to drop such periods instead.
+//
+// Merge sorts the periods once and then merges them in a single linear sweep,
+// so it runs in O(n log n) time for n periods. Unless [MergeInPlace] is used,
+// the input is copied once and left unchanged.
+func Merge(periods []Period, opts ...MergeOption) []Period {
+	var cfg mergeConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if !cfg.inPlace {
+		periods = slices.Clone(periods)
+	}
+
+	for i, p := range periods {
+		periods[i] = p.closed()
+	}
+
+	return reopenAll(mergeClosed(periods, cfg.step))
+}
+
+// mergeClosed sorts and merges periods whose open boundaries have been closed
+// using [Period.closed], reusing the given slice. The result is closed as
+// well, so merging an open start with an open end does not produce the empty
+// period.
+func mergeClosed(periods []Period, step time.Duration) []Period {
+	SortPeriods(periods)
+
+	merged := periods[:0]
+	for _, p := range periods {
+		if p.IsZero() {
+			continue
+		}
+
+		if len(merged) == 0 {
+			merged = append(merged, p)
+			continue
+		}
+
+		last := &merged[len(merged)-1]
+		if last.OverlapsWithStep(step, p) {
+			if p.End.After(last.End) {
+				last.End = p.End
+			}
+			continue
+		}
+
+		merged = append(merged, p)
+	}
+
+	return merged
+}
+
+// closed returns the period with its open boundaries replaced by times that lie
+// far in the past or future. See [Period.bounds].
+func (p Period) closed() Period {
+	start, end := p.bounds()
+	return Period{Start: start, End: end}
+}
+
+// reopened is the inverse of [Period.closed]. A period that is unbounded in
+// both directions cannot be represented using zero boundaries, because that
+// would be the empty period, so it keeps its far boundaries.
+func (p Period) reopened() Period {
+	startOpen, endOpen := p.Start.Equal(farPast), p.End.Equal(farFuture)
+	if startOpen && endOpen {
+		return p
+	}
+	if startOpen {
+		p.Start = time.Time{}
+	}
+	if endOpen {
+		p.End = time.Time{}
+	}
+	return p
+}
+
+// mergedClosed returns a merged copy of the periods with closed boundaries.
+func mergedClosed(periods []Period) []Period {
+	return mergeClosed(slice.Map(periods, Period.closed), 0)
 }
 
 // MergePeriods consolidates a slice of [Period]s by combining those that
@@ -421,21 +1377,19 @@ func (p Period) CutInclusive(cut ...Period) []Period {
 // Adjacency is determined without any minimum duration step, meaning that
 // periods touching at their boundaries are considered overlapping.
 func MergePeriods(periods []Period) []Period {
-	return MergePeriodsStep(0, periods)
+	return Merge(periods)
 }
 
 // MergePeriodsStep merges a slice of [Period]s into a continuous sequence where
 // overlapping periods are combined based on a specified minimum duration step.
 // It ensures that any periods that overlap by at least the given step duration
 // are joined into single periods, producing a consolidated timeline. The result
-// is a slice of non-overlapping [Period]s sorted by their start times. If the
-// step duration is zero, adjacent periods will be merged even if they only
-// touch at the end and start times.
+// is sorted by their start times; periods that overlap by less than the step
+// are kept separate, as described for [Merge]. If the step duration is zero,
+// adjacent periods will be merged even if they only touch at the end and start
+// times.
 func MergePeriodsStep(step time.Duration, periods []Period) []Period {
-	if len(periods) < 2 {
-		return periods
-	}
-	return periods[0].MergeStep(step, periods[1:])
+	return Merge(periods, MergeWithStep(step))
 }
 
 // MergeStep merges the [Period] with a slice of other periods, ensuring that
@@ -449,41 +1403,54 @@ func (p Period) MergeStep(step time.Duration, periods []Period) []Period {
 	if len(periods) == 0 {
 		return []Period{p}
 	}
+	return Merge(append([]Period{p}, periods...), MergeWithStep(step), MergeInPlace())
+}
 
-	periods = append([]Period{p}, periods...)
-
-	sort.Slice(periods, func(i, j int) bool {
-		return periods[i].Start.Before(periods[j].Start)
-	})
-
-	merged := []Period{periods[0]}
+// Merge combines the receiver [Period] with a slice of other [Period]s and
+// returns a new slice of merged [Period]s. Overlapping periods are consolidated
+// into single periods, while non-overlapping periods remain separate. The merge
+// process respects the chronological order of periods.
+func (p Period) Merge(periods []Period) []Period {
+	return p.MergeStep(0, periods)
+}
 
-	for _, p := range periods[1:] {
-		last := &merged[len(merged)-1]
+var (
+	farPast   = time.Unix(-1<<62, 0).UTC()
+	farFuture = time.Unix(1<<62, 0).UTC()
+)
 
-		if last.OverlapsWithStep(step, p) {
-			last.End = maxTime(last.End, p.End)
-		} else if SameOrBefore(last.End, p.Start) {
-			merged = append(merged, p)
-		}
+// fastPathLimit bounds the Unix nanoseconds of the times that take the UTC
+// fast path, which compares int64 nanoseconds instead of [time.Time] values.
+// It covers the years 1824 to 2116 and ensures that subtracting a step of up
+// to the same size cannot overflow.
+const fastPathLimit = 1 << 62
+
+// utcNanos returns t as Unix nanoseconds if t is a UTC time within
+// [fastPathLimit], which is never the case for the zero time.
+func utcNanos(t time.Time) (int64, bool) {
+	if t.Location() != time.UTC {
+		return 0, false
 	}
-
-	return merged
+	if sec := t.Unix(); sec <= -fastPathLimit/int64(time.Second) || sec >= fastPathLimit/int64(time.Second) {
+		return 0, false
+	}
+	return t.UnixNano(), true
 }
 
-func maxTime(a, b time.Time) time.Time {
-	if a.After(b) {
-		return a
+// utcNanos returns the boundaries of the period as Unix nanoseconds if both
+// are UTC times within [fastPathLimit]. Open periods never take the fast path.
+func (p Period) utcNanos() (start, end int64, ok bool) {
+	if start, ok = utcNanos(p.Start); !ok {
+		return 0, 0, false
+	}
+	if end, ok = utcNanos(p.End); !ok {
+		return 0, 0, false
 	}
-	return b
+	return start, end, true
 }
 
-// Merge combines the receiver [Period] with a slice of other [Period]s and
-// returns a new slice of merged [Period]s. Overlapping periods are consolidated
-// into single periods, while non-overlapping periods remain separate. The merge
-// process respects the chronological order of periods.
-func (p Period) Merge(periods []Period) []Period {
-	return p.MergeStep(0, periods)
+func betweenNanos(n, l, r int64) bool {
+	return l <= n && n <= r
 }
 
 func absoluteStep(step time.Duration) time.Duration {
@@ -1,6 +1,8 @@
 package timefn_test
 
 import (
+	"math"
+	"math/rand"
 	"reflect"
 	"slices"
 	"testing"
@@ -574,3 +576,1344 @@ func TestPeriod_Merge(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriod_MonthsStep(t *testing.T) {
+	tests := []struct {
+		period timefn.Period
+		step   time.Duration
+		want   []timefn.Month
+	}{
+		{
+			period: timefn.Period{
+				Start: time.Date(2020, time.November, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC),
+			},
+			step: 0,
+			want: []timefn.Month{{2020, time.November}, {2020, time.December}, {2021, time.January}, {2021, time.February}},
+		},
+		{
+			period: timefn.Period{
+				Start: time.Date(2020, time.November, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC),
+			},
+			step: time.Nanosecond,
+			want: []timefn.Month{{2020, time.November}, {2020, time.December}, {2021, time.January}},
+		},
+		{
+			period: timefn.Period{
+				Start: time.Date(2020, time.November, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2020, time.November, 16, 0, 0, 0, 0, time.UTC),
+			},
+			step: time.Hour,
+			want: []timefn.Month{{2020, time.November}},
+		},
+		{
+			period: timefn.Period{
+				Start: time.Date(-1, time.November, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(0, time.February, 1, 0, 0, 0, 0, time.UTC),
+			},
+			step: time.Nanosecond,
+			want: []timefn.Month{{-1, time.November}, {-1, time.December}, {0, time.January}},
+		},
+	}
+
+	for _, tt := range tests {
+		months := tt.period.MonthsStep(tt.step)
+
+		if !slices.Equal(months, tt.want) {
+			t.Errorf("expected months of %s with step %s to be %v; got %v", tt.period, tt.step, tt.want, months)
+		}
+	}
+}
+
+func TestPeriod_QuartersStep(t *testing.T) {
+	tests := []struct {
+		period timefn.Period
+		step   time.Duration
+		want   []timefn.Quarter
+	}{
+		{
+			period: timefn.Period{
+				Start: time.Date(2020, time.November, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+			},
+			step: 0,
+			want: []timefn.Quarter{{2020, 4}, {2021, 1}, {2021, 2}},
+		},
+		{
+			period: timefn.Period{
+				Start: time.Date(2020, time.November, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+			},
+			step: time.Nanosecond,
+			want: []timefn.Quarter{{2020, 4}, {2021, 1}},
+		},
+		{
+			period: timefn.Period{
+				Start: time.Date(-1, time.August, 15, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(0, time.April, 1, 0, 0, 0, 0, time.UTC),
+			},
+			step: time.Nanosecond,
+			want: []timefn.Quarter{{-1, 3}, {-1, 4}, {0, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		quarters := tt.period.QuartersStep(tt.step)
+
+		if !slices.Equal(quarters, tt.want) {
+			t.Errorf("expected quarters of %s with step %s to be %v; got %v", tt.period, tt.step, tt.want, quarters)
+		}
+	}
+}
+
+func TestPeriod_IsActive(t *testing.T) {
+	now := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	defer freezeNow(now)()
+
+	tests := []struct {
+		name       string
+		period     timefn.Period
+		wantActive bool
+		wantOver   bool
+	}{
+		{
+			name:       "not yet started",
+			period:     timefn.Period{Start: now.Add(time.Nanosecond), End: now.Add(time.Hour)},
+			wantActive: false,
+			wantOver:   false,
+		},
+		{
+			name:       "starts now",
+			period:     timefn.Period{Start: now, End: now.Add(time.Hour)},
+			wantActive: true,
+			wantOver:   false,
+		},
+		{
+			name:       "ends now",
+			period:     timefn.Period{Start: now.Add(-time.Hour), End: now},
+			wantActive: false,
+			wantOver:   true,
+		},
+		{
+			name:       "ended",
+			period:     timefn.Period{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)},
+			wantActive: false,
+			wantOver:   true,
+		},
+		{
+			name:       "open end",
+			period:     timefn.Period{Start: now.Add(-time.Hour)},
+			wantActive: true,
+			wantOver:   false,
+		},
+		{
+			name:       "open start",
+			period:     timefn.Period{End: now.Add(time.Hour)},
+			wantActive: true,
+			wantOver:   false,
+		},
+		{
+			name:       "open start ended",
+			period:     timefn.Period{End: now},
+			wantActive: false,
+			wantOver:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.IsActive(); got != tt.wantActive {
+				t.Errorf("IsActive() = %v, want %v", got, tt.wantActive)
+			}
+			if got := tt.period.IsOver(); got != tt.wantOver {
+				t.Errorf("IsOver() = %v, want %v", got, tt.wantOver)
+			}
+		})
+	}
+}
+
+func TestPeriod_StartsIn(t *testing.T) {
+	now := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	defer freezeNow(now)()
+
+	p := timefn.Period{Start: now.Add(time.Hour), End: now.Add(3 * time.Hour)}
+
+	if got := p.StartsIn(); got != time.Hour {
+		t.Errorf("StartsIn() = %v, want %v", got, time.Hour)
+	}
+
+	if got := p.EndsIn(); got != 3*time.Hour {
+		t.Errorf("EndsIn() = %v, want %v", got, 3*time.Hour)
+	}
+
+	open := timefn.Period{Start: now.Add(-time.Hour)}
+
+	if got := open.StartsIn(); got != -time.Hour {
+		t.Errorf("StartsIn() of open-ended period = %v, want %v", got, -time.Hour)
+	}
+
+	if got, want := open.EndsIn(), time.Duration(math.MaxInt64); got != want {
+		t.Errorf("EndsIn() of open-ended period = %v, want %v", got, want)
+	}
+}
+
+func TestPeriod_Normalize(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		want   timefn.Period
+	}{
+		{
+			name:   "ordered",
+			period: timefn.Period{Start: jan1, End: jan3},
+			want:   timefn.Period{Start: jan1, End: jan3},
+		},
+		{
+			name:   "reversed",
+			period: timefn.Period{Start: jan3, End: jan1},
+			want:   timefn.Period{Start: jan1, End: jan3},
+		},
+		{
+			name:   "zero start",
+			period: timefn.Period{End: jan1},
+			want:   timefn.Period{End: jan1},
+		},
+		{
+			name:   "zero end",
+			period: timefn.Period{Start: jan3},
+			want:   timefn.Period{Start: jan3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Normalize(); got != tt.want {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_AddDate(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	p := timefn.Period{
+		Start: time.Date(2023, time.March, 20, 9, 0, 0, 0, berlin),
+		End:   time.Date(2023, time.March, 20, 17, 0, 0, 0, berlin),
+	}
+
+	got := p.AddDate(0, 0, 7)
+	want := timefn.Period{
+		Start: time.Date(2023, time.March, 27, 9, 0, 0, 0, berlin),
+		End:   time.Date(2023, time.March, 27, 17, 0, 0, 0, berlin),
+	}
+	if !got.Start.Equal(want.Start) || !got.End.Equal(want.End) {
+		t.Errorf("AddDate(0, 0, 7) = %v, want %v", got, want)
+	}
+
+	got = p.AddDate(0, 1, 0)
+	want = timefn.Period{
+		Start: time.Date(2023, time.April, 20, 9, 0, 0, 0, berlin),
+		End:   time.Date(2023, time.April, 20, 17, 0, 0, 0, berlin),
+	}
+	if !got.Start.Equal(want.Start) || !got.End.Equal(want.End) {
+		t.Errorf("AddDate(0, 1, 0) = %v, want %v", got, want)
+	}
+
+	open := timefn.Period{Start: p.Start}
+	if got := open.AddDate(1, 0, 0); !got.End.IsZero() || !got.Start.Equal(p.Start.AddDate(1, 0, 0)) {
+		t.Errorf("AddDate(1, 0, 0) = %v, want open end", got)
+	}
+}
+
+func TestPeriod_Buffer(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC)
+	jan1End := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+	p := timefn.Period{Start: jan1, End: jan1End}
+
+	tests := []struct {
+		name          string
+		period        timefn.Period
+		before, after time.Duration
+		want          timefn.Period
+	}{
+		{
+			name:   "expand",
+			period: p,
+			before: 15 * time.Minute,
+			after:  30 * time.Minute,
+			want:   timefn.Period{Start: jan1.Add(-15 * time.Minute), End: jan1End.Add(30 * time.Minute)},
+		},
+		{
+			name:   "shrink",
+			period: p,
+			before: -30 * time.Minute,
+			after:  -time.Hour,
+			want:   timefn.Period{Start: jan1.Add(30 * time.Minute), End: jan1End.Add(-time.Hour)},
+		},
+		{
+			name:   "shrink past itself",
+			period: p,
+			before: -2 * time.Hour,
+			after:  -time.Hour,
+			want:   timefn.Period{Start: jan1.Add(90 * time.Minute), End: jan1.Add(90 * time.Minute)},
+		},
+		{
+			name:   "open end",
+			period: timefn.Period{Start: jan1},
+			before: time.Hour,
+			after:  time.Hour,
+			want:   timefn.Period{Start: jan1.Add(-time.Hour)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Buffer(tt.before, tt.after); got != tt.want {
+				t.Errorf("Buffer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_Elapsed(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	p := timefn.Period{Start: jan1, End: jan3}
+
+	tests := []struct {
+		name          string
+		period        timefn.Period
+		at            time.Time
+		wantElapsed   time.Duration
+		wantRemaining time.Duration
+	}{
+		{
+			name:          "before start",
+			period:        p,
+			at:            jan1.Add(-time.Hour),
+			wantElapsed:   0,
+			wantRemaining: 48 * time.Hour,
+		},
+		{
+			name:          "in between",
+			period:        p,
+			at:            jan1.Add(12 * time.Hour),
+			wantElapsed:   12 * time.Hour,
+			wantRemaining: 36 * time.Hour,
+		},
+		{
+			name:          "after end",
+			period:        p,
+			at:            jan3.Add(time.Hour),
+			wantElapsed:   48 * time.Hour,
+			wantRemaining: 0,
+		},
+		{
+			name:          "inverted period",
+			period:        timefn.Period{Start: jan3, End: jan1},
+			at:            jan1.Add(12 * time.Hour),
+			wantElapsed:   0,
+			wantRemaining: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Elapsed(tt.at); got != tt.wantElapsed {
+				t.Errorf("Elapsed() = %v, want %v", got, tt.wantElapsed)
+			}
+			if got := tt.period.Remaining(tt.at); got != tt.wantRemaining {
+				t.Errorf("Remaining() = %v, want %v", got, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestPeriod_Contains_open(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	far := time.Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		t      time.Time
+		want   bool
+	}{
+		{name: "open end, before start", period: timefn.Period{Start: jan3}, t: jan1, want: false},
+		{name: "open end, at start", period: timefn.Period{Start: jan3}, t: jan3, want: true},
+		{name: "open end, far future", period: timefn.Period{Start: jan3}, t: far, want: true},
+		{name: "open start, far past", period: timefn.Period{End: jan3}, t: time.Time{}.Add(time.Hour), want: true},
+		{name: "open start, at end", period: timefn.Period{End: jan3}, t: jan3, want: false},
+		{name: "empty period", period: timefn.Period{}, t: jan1, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Contains(tt.t); got != tt.want {
+				t.Errorf("%s.Contains(%v) = %v, want %v", tt.period, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_OverlapsWith_open(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	jan7 := time.Date(2023, time.January, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		a, b timefn.Period
+		want bool
+	}{
+		{name: "open end overlaps later period", a: timefn.Period{Start: jan1}, b: timefn.Period{Start: jan3, End: jan7}, want: true},
+		{name: "open end does not overlap earlier period", a: timefn.Period{Start: jan3}, b: timefn.Period{Start: jan1, End: jan3}, want: false},
+		{name: "open start overlaps earlier period", a: timefn.Period{End: jan7}, b: timefn.Period{Start: jan1, End: jan3}, want: true},
+		{name: "open start and open end", a: timefn.Period{End: jan7}, b: timefn.Period{Start: jan3}, want: true},
+		{name: "disjoint open periods", a: timefn.Period{End: jan1}, b: timefn.Period{Start: jan3}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.OverlapsWith(tt.b); got != tt.want {
+				t.Errorf("%s.OverlapsWith(%s) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_Cut_open(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	jan6 := time.Date(2023, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		cut    timefn.Period
+		want   []timefn.Period
+	}{
+		{
+			name:   "cut from open end",
+			period: timefn.Period{Start: jan1},
+			cut:    timefn.Period{Start: jan3, End: jan6},
+			want:   []timefn.Period{{Start: jan1, End: jan3}, {Start: jan6}},
+		},
+		{
+			name:   "cut open end from open end",
+			period: timefn.Period{Start: jan1},
+			cut:    timefn.Period{Start: jan3},
+			want:   []timefn.Period{{Start: jan1, End: jan3}},
+		},
+		{
+			name:   "open cut removes period",
+			period: timefn.Period{Start: jan3, End: jan6},
+			cut:    timefn.Period{Start: jan1},
+			want:   nil,
+		},
+		{
+			name:   "cut from open start",
+			period: timefn.Period{End: jan6},
+			cut:    timefn.Period{Start: jan1, End: jan3},
+			want:   []timefn.Period{{End: jan1}, {Start: jan3, End: jan6}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.period.Cut(tt.cut)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_Merge_open(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	jan6 := time.Date(2023, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+	got := timefn.Period{Start: jan1, End: jan3}.Merge([]timefn.Period{{Start: jan3}, {Start: jan6, End: jan6.Add(time.Hour)}})
+	want := []timefn.Period{{Start: jan1}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+}
+
+func TestPeriod_Validate(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		period  timefn.Period
+		opts    []timefn.ValidateOption
+		wantErr bool
+	}{
+		{name: "valid", period: timefn.Period{Start: jan1, End: jan3}},
+		{name: "empty", period: timefn.Period{}, wantErr: true},
+		{name: "empty, open allowed", period: timefn.Period{}, opts: []timefn.ValidateOption{timefn.AllowOpenStart(), timefn.AllowOpenEnd()}, wantErr: true},
+		{name: "inverted", period: timefn.Period{Start: jan3, End: jan1}, wantErr: true},
+		{name: "open end", period: timefn.Period{Start: jan1}, wantErr: true},
+		{name: "open end, allowed", period: timefn.Period{Start: jan1}, opts: []timefn.ValidateOption{timefn.AllowOpenEnd()}},
+		{name: "open end, only open start allowed", period: timefn.Period{Start: jan1}, opts: []timefn.ValidateOption{timefn.AllowOpenStart()}, wantErr: true},
+		{name: "open start, allowed", period: timefn.Period{End: jan1}, opts: []timefn.ValidateOption{timefn.AllowOpenStart()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.period.Validate(tt.opts...)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected %s to be invalid", tt.period)
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("expected %s to be valid; got %v", tt.period, err)
+			}
+		})
+	}
+}
+
+func TestPeriod_Format_open(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	if got, want := (timefn.Period{Start: jan1}).Format(), jan1.String()+" -> +inf"; got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+
+	if got, want := (timefn.Period{End: jan1}).Format(), "-inf -> "+jan1.String(); got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+
+	if got, want := (timefn.Period{Start: jan1}).FormatAs(`{{ .Start.Format "2006-01-02" }} -> {{ .End }}`), "2023-01-01 -> +inf"; got != want {
+		t.Errorf("FormatAs() = %q, want %q", got, want)
+	}
+}
+
+func TestPeriod_In(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	p := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, berlin),
+		End:   time.Date(2023, time.January, 2, 0, 0, 0, 0, berlin),
+	}
+
+	utc := p.UTC()
+	want := timefn.Period{
+		Start: time.Date(2022, time.December, 31, 23, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.January, 1, 23, 0, 0, 0, time.UTC),
+	}
+
+	if utc != want {
+		t.Errorf("UTC() = %v, want %v", utc, want)
+	}
+
+	if back := utc.In(berlin); !back.Start.Equal(p.Start) || !back.End.Equal(p.End) || back.Start.Location() != berlin {
+		t.Errorf("In(%v) = %v, want %v", berlin, back, p)
+	}
+
+	if open := (timefn.Period{Start: p.Start}).UTC(); open.End != (time.Time{}) {
+		t.Errorf("UTC() should leave a zero End untouched; got %v", open.End)
+	}
+}
+
+func TestPeriod_EqualWithin(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	p := timefn.Period{Start: jan1, End: jan3}
+
+	tests := []struct {
+		name      string
+		other     timefn.Period
+		tolerance time.Duration
+		want      bool
+	}{
+		{name: "identical", other: p, tolerance: 0, want: true},
+		{name: "within tolerance", other: timefn.Period{Start: jan1.Add(999 * time.Millisecond), End: jan3.Add(-time.Second)}, tolerance: time.Second, want: true},
+		{name: "start outside tolerance", other: timefn.Period{Start: jan1.Add(-time.Second - time.Nanosecond), End: jan3}, tolerance: time.Second, want: false},
+		{name: "end outside tolerance", other: timefn.Period{Start: jan1, End: jan3.Add(2 * time.Second)}, tolerance: time.Second, want: false},
+		{name: "same instant, different location", other: timefn.Period{Start: jan1.In(time.FixedZone("", 3600)), End: jan3}, tolerance: 0, want: true},
+		{name: "open end", other: timefn.Period{Start: jan1}, tolerance: time.Hour, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.EqualWithin(tt.other, tt.tolerance); got != tt.want {
+				t.Errorf("%s.EqualWithin(%s, %s) = %v, want %v", p, tt.other, tt.tolerance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_Progress(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan5 := time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC)
+	p := timefn.Period{Start: jan1, End: jan5}
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		t      time.Time
+		want   float64
+	}{
+		{name: "before start", period: p, t: jan1.Add(-time.Hour), want: 0},
+		{name: "at start", period: p, t: jan1, want: 0},
+		{name: "quarter", period: p, t: jan1.AddDate(0, 0, 1), want: 0.25},
+		{name: "at end", period: p, t: jan5, want: 1},
+		{name: "after end", period: p, t: jan5.Add(time.Hour), want: 1},
+		{name: "zero-length, before", period: timefn.Period{Start: jan1, End: jan1}, t: jan1.Add(-1), want: 0},
+		{name: "zero-length, at start", period: timefn.Period{Start: jan1, End: jan1}, t: jan1, want: 1},
+		{name: "inverted", period: timefn.Period{Start: jan5, End: jan1}, t: jan5.Add(time.Hour), want: 0},
+		{name: "open end", period: timefn.Period{Start: jan1}, t: jan5, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Progress(tt.t); got != tt.want {
+				t.Errorf("%s.Progress(%v) = %v, want %v", tt.period, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNearest(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	jan5 := time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC)
+	jan9 := time.Date(2023, time.January, 9, 0, 0, 0, 0, time.UTC)
+
+	periods := []timefn.Period{
+		{Start: jan1, End: jan3},
+		{},
+		{Start: jan5, End: jan9},
+	}
+
+	tests := []struct {
+		name      string
+		periods   []timefn.Period
+		t         time.Time
+		want      timefn.Period
+		wantDist  time.Duration
+		wantFound bool
+	}{
+		{name: "containing", periods: periods, t: jan1.Add(time.Hour), want: periods[0], wantDist: 0, wantFound: true},
+		{name: "at end", periods: periods, t: jan3, want: periods[0], wantDist: 0, wantFound: true},
+		{name: "closer to later period", periods: periods, t: jan5.Add(-time.Hour), want: periods[2], wantDist: time.Hour, wantFound: true},
+		{name: "tie", periods: periods, t: jan3.AddDate(0, 0, 1), want: periods[0], wantDist: 24 * time.Hour, wantFound: true},
+		{name: "after all", periods: periods, t: jan9.Add(time.Minute), want: periods[2], wantDist: time.Minute, wantFound: true},
+		{name: "no periods", periods: []timefn.Period{{}}, t: jan1, wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, dist, found := timefn.Nearest(tt.periods, tt.t)
+
+			if got != tt.want || dist != tt.wantDist || found != tt.wantFound {
+				t.Errorf("Nearest(%v) = (%v, %v, %v), want (%v, %v, %v)", tt.t, got, dist, found, tt.want, tt.wantDist, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestPeriod_Midpoint(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	p := timefn.Period{Start: jan1, End: jan1.Add(3 * time.Hour)}
+
+	if got, want := p.Midpoint(), jan1.Add(90*time.Minute); !got.Equal(want) {
+		t.Errorf("Midpoint() = %v, want %v", got, want)
+	}
+}
+
+func TestPeriod_Random(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	p := timefn.Period{Start: jan1, End: jan1.Add(time.Hour)}
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		if got := p.Random(r); !p.Contains(got) {
+			t.Fatalf("Random() returned %v, which is not within %s", got, p)
+		}
+	}
+
+	if got := p.Random(nil); !p.Contains(got) {
+		t.Errorf("Random(nil) returned %v, which is not within %s", got, p)
+	}
+
+	empty := timefn.Period{Start: jan1, End: jan1}
+	if got := empty.Random(r); !got.Equal(jan1) {
+		t.Errorf("Random() of a zero-length period should return its start; got %v", got)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	jan5 := time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC)
+	jan9 := time.Date(2023, time.January, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		a, b timefn.Period
+		want time.Duration
+	}{
+		{name: "overlapping", a: timefn.Period{Start: jan1, End: jan5}, b: timefn.Period{Start: jan3, End: jan9}, want: 0},
+		{name: "adjacent", a: timefn.Period{Start: jan1, End: jan3}, b: timefn.Period{Start: jan3, End: jan5}, want: 0},
+		{name: "gap", a: timefn.Period{Start: jan1, End: jan3}, b: timefn.Period{Start: jan5, End: jan9}, want: 48 * time.Hour},
+		{name: "open end", a: timefn.Period{Start: jan1}, b: timefn.Period{Start: jan5, End: jan9}, want: 0},
+		{name: "open start", a: timefn.Period{End: jan3}, b: timefn.Period{Start: jan5, End: jan9}, want: 48 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.Distance(tt.a, tt.b); got != tt.want {
+				t.Errorf("Distance(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := timefn.Distance(tt.b, tt.a); got != tt.want {
+				t.Errorf("Distance(%s, %s) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComparePeriods(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	jan5 := time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		a, b timefn.Period
+		want int
+	}{
+		{name: "equal", a: timefn.Period{Start: jan1, End: jan3}, b: timefn.Period{Start: jan1, End: jan3}, want: 0},
+		{name: "earlier start", a: timefn.Period{Start: jan1, End: jan5}, b: timefn.Period{Start: jan3, End: jan5}, want: -1},
+		{name: "later start", a: timefn.Period{Start: jan3, End: jan5}, b: timefn.Period{Start: jan1, End: jan3}, want: 1},
+		{name: "same start, earlier end", a: timefn.Period{Start: jan1, End: jan3}, b: timefn.Period{Start: jan1, End: jan5}, want: -1},
+		{name: "open start", a: timefn.Period{End: jan5}, b: timefn.Period{Start: jan1, End: jan3}, want: -1},
+		{name: "open end", a: timefn.Period{Start: jan1}, b: timefn.Period{Start: jan1, End: jan5}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.ComparePeriods(tt.a, tt.b); got != tt.want {
+				t.Errorf("ComparePeriods(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortPeriods(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	jan5 := time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	periods := []timefn.Period{
+		{Start: jan3, End: jan5},
+		{Start: jan1, End: jan5},
+		{Start: jan1, End: jan3},
+	}
+	timefn.SortPeriods(periods)
+
+	want := []timefn.Period{
+		{Start: jan1, End: jan3},
+		{Start: jan1, End: jan5},
+		{Start: jan3, End: jan5},
+	}
+
+	if !slices.Equal(periods, want) {
+		t.Errorf("SortPeriods() = %v, want %v", periods, want)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return jan1.AddDate(0, 0, d-1) }
+
+	tests := []struct {
+		name    string
+		periods []timefn.Period
+		opts    []timefn.MergeOption
+		want    []timefn.Period
+	}{
+		{
+			name:    "nil",
+			periods: nil,
+			want:    nil,
+		},
+		{
+			name: "unsorted overlapping and adjacent periods",
+			periods: []timefn.Period{
+				{Start: day(8), End: day(9)},
+				{Start: day(3), End: day(5)},
+				{},
+				{Start: day(1), End: day(3)},
+				{Start: day(4), End: day(6)},
+			},
+			want: []timefn.Period{
+				{Start: day(1), End: day(6)},
+				{Start: day(8), End: day(9)},
+			},
+		},
+		{
+			name: "adjacent periods with step",
+			periods: []timefn.Period{
+				{Start: day(3), End: day(5)},
+				{Start: day(1), End: day(3)},
+			},
+			opts: []timefn.MergeOption{timefn.MergeWithStep(time.Nanosecond)},
+			want: []timefn.Period{
+				{Start: day(1), End: day(3)},
+				{Start: day(3), End: day(5)},
+			},
+		},
+		{
+			name: "overlap shorter than step",
+			periods: []timefn.Period{
+				{Start: day(1), End: day(3)},
+				{Start: day(3).Add(-time.Minute), End: day(5)},
+			},
+			opts: []timefn.MergeOption{timefn.MergeWithStep(time.Hour)},
+			want: []timefn.Period{
+				{Start: day(1), End: day(3)},
+				{Start: day(3).Add(-time.Minute), End: day(5)},
+			},
+		},
+		{
+			name:    "zero periods only",
+			periods: []timefn.Period{{}, {}},
+			want:    []timefn.Period{},
+		},
+		{
+			name: "instants",
+			periods: []timefn.Period{
+				{Start: day(7), End: day(7)},
+				{Start: day(2), End: day(2)},
+				{Start: day(1), End: day(3)},
+			},
+			want: []timefn.Period{
+				{Start: day(1), End: day(3)},
+				{Start: day(7), End: day(7)},
+			},
+		},
+		{
+			name: "partial overlap shorter than step is kept",
+			periods: []timefn.Period{
+				{Start: day(1), End: day(5)},
+				{Start: day(2), End: day(3)},
+				{Start: day(5).Add(-time.Minute), End: day(6)},
+			},
+			opts: []timefn.MergeOption{timefn.MergeWithStep(time.Hour)},
+			want: []timefn.Period{
+				{Start: day(1), End: day(5)},
+				{Start: day(5).Add(-time.Minute), End: day(6)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := slices.Clone(tt.periods)
+			got := timefn.Merge(tt.periods, tt.opts...)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Merge() = %v, want %v", got, tt.want)
+			}
+
+			if !slices.Equal(tt.periods, input) {
+				t.Errorf("Merge() should not modify its input")
+			}
+		})
+	}
+}
+
+func TestMerge_inPlace(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	periods := []timefn.Period{
+		{Start: jan1.Add(time.Hour), End: jan1.Add(3 * time.Hour)},
+		{Start: jan1, End: jan1.Add(2 * time.Hour)},
+	}
+
+	got := timefn.Merge(periods, timefn.MergeInPlace())
+	want := []timefn.Period{{Start: jan1, End: jan1.Add(3 * time.Hour)}}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+
+	if &got[0] != &periods[0] {
+		t.Errorf("Merge() with MergeInPlace should reuse the input slice")
+	}
+}
+
+func BenchmarkMerge(b *testing.B) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	r := rand.New(rand.NewSource(1))
+	periods := make([]timefn.Period, 50000)
+	for i := range periods {
+		start := jan1.Add(time.Duration(r.Int63n(int64(365 * 24 * time.Hour))))
+		periods[i] = timefn.Period{Start: start, End: start.Add(time.Duration(r.Int63n(int64(4 * time.Hour))))}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		timefn.Merge(periods)
+	}
+}
+
+func TestPeriod_SplitWeighted(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	p := timefn.Period{Start: jan1, End: jan1.Add(10 * time.Hour)}
+
+	tests := []struct {
+		name    string
+		period  timefn.Period
+		weights []float64
+		want    []timefn.Period
+	}{
+		{
+			name:    "proportional",
+			period:  p,
+			weights: []float64{1, 3, 1},
+			want: []timefn.Period{
+				{Start: jan1, End: jan1.Add(2 * time.Hour)},
+				{Start: jan1.Add(2 * time.Hour), End: jan1.Add(8 * time.Hour)},
+				{Start: jan1.Add(8 * time.Hour), End: jan1.Add(10 * time.Hour)},
+			},
+		},
+		{
+			name:    "zero weight",
+			period:  p,
+			weights: []float64{1, 0, 1},
+			want: []timefn.Period{
+				{Start: jan1, End: jan1.Add(5 * time.Hour)},
+				{Start: jan1.Add(5 * time.Hour), End: jan1.Add(5 * time.Hour)},
+				{Start: jan1.Add(5 * time.Hour), End: jan1.Add(10 * time.Hour)},
+			},
+		},
+		{
+			name:    "rounding",
+			period:  timefn.Period{Start: jan1, End: jan1.Add(10)},
+			weights: []float64{1, 1, 1},
+			want: []timefn.Period{
+				{Start: jan1, End: jan1.Add(3)},
+				{Start: jan1.Add(3), End: jan1.Add(7)},
+				{Start: jan1.Add(7), End: jan1.Add(10)},
+			},
+		},
+		{
+			name:    "beyond float64 precision",
+			period:  timefn.Period{Start: jan1, End: jan1.Add(1 << 60)},
+			weights: []float64{1, 2},
+			want: []timefn.Period{
+				{Start: jan1, End: jan1.Add(384307168202282325)},
+				{Start: jan1.Add(384307168202282325), End: jan1.Add(1 << 60)},
+			},
+		},
+		{name: "negative weight", period: p, weights: []float64{1, -1}, want: nil},
+		{name: "zero sum", period: p, weights: []float64{0, 0}, want: nil},
+		{name: "no weights", period: p, weights: nil, want: nil},
+		{name: "invalid period", period: timefn.Period{Start: jan1}, weights: []float64{1}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.SplitWeighted(tt.weights); !slices.Equal(got, tt.want) {
+				t.Errorf("SplitWeighted(%v) = %v, want %v", tt.weights, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return jan1.AddDate(0, 0, d-1) }
+
+	tests := []struct {
+		name   string
+		from   []timefn.Period
+		remove []timefn.Period
+		want   []timefn.Period
+	}{
+		{
+			name:   "nothing to remove",
+			from:   []timefn.Period{{Start: day(3), End: day(5)}, {Start: day(1), End: day(3)}},
+			remove: nil,
+			want:   []timefn.Period{{Start: day(1), End: day(5)}},
+		},
+		{
+			name: "multiple removals spanning periods",
+			from: []timefn.Period{
+				{Start: day(1), End: day(10)},
+				{Start: day(12), End: day(20)},
+			},
+			remove: []timefn.Period{
+				{Start: day(15), End: day(16)},
+				{Start: day(2), End: day(3)},
+				{Start: day(9), End: day(13)},
+				{Start: day(19), End: day(25)},
+			},
+			want: []timefn.Period{
+				{Start: day(1), End: day(2)},
+				{Start: day(3), End: day(9)},
+				{Start: day(13), End: day(15)},
+				{Start: day(16), End: day(19)},
+			},
+		},
+		{
+			name:   "everything removed",
+			from:   []timefn.Period{{Start: day(2), End: day(3)}, {Start: day(5), End: day(6)}},
+			remove: []timefn.Period{{Start: day(1), End: day(10)}},
+			want:   nil,
+		},
+		{
+			name:   "open-ended",
+			from:   []timefn.Period{{Start: day(1)}},
+			remove: []timefn.Period{{Start: day(2), End: day(3)}, {Start: day(5)}},
+			want:   []timefn.Period{{Start: day(1), End: day(2)}, {Start: day(3), End: day(5)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.Subtract(tt.from, tt.remove); !slices.Equal(got, tt.want) {
+				t.Errorf("Subtract() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return jan1.AddDate(0, 0, d-1) }
+
+	tests := []struct {
+		name string
+		a, b []timefn.Period
+		want []timefn.Period
+	}{
+		{
+			name: "disjoint",
+			a:    []timefn.Period{{Start: day(1), End: day(2)}},
+			b:    []timefn.Period{{Start: day(2), End: day(3)}},
+			want: nil,
+		},
+		{
+			name: "multiple overlaps",
+			a: []timefn.Period{
+				{Start: day(1), End: day(5)},
+				{Start: day(8), End: day(12)},
+			},
+			b: []timefn.Period{
+				{Start: day(10), End: day(15)},
+				{Start: day(0), End: day(2)},
+				{Start: day(4), End: day(9)},
+			},
+			want: []timefn.Period{
+				{Start: day(1), End: day(2)},
+				{Start: day(4), End: day(5)},
+				{Start: day(8), End: day(9)},
+				{Start: day(10), End: day(12)},
+			},
+		},
+		{
+			name: "open-ended",
+			a:    []timefn.Period{{Start: day(3)}},
+			b:    []timefn.Period{{End: day(5)}, {Start: day(7)}},
+			want: []timefn.Period{{Start: day(3), End: day(5)}, {Start: day(7)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.Intersect(tt.a, tt.b); !slices.Equal(got, tt.want) {
+				t.Errorf("Intersect() = %v, want %v", got, tt.want)
+			}
+			if got := timefn.Intersect(tt.b, tt.a); !slices.Equal(got, tt.want) {
+				t.Errorf("Intersect() is not symmetric; got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRescale(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	feb1 := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	from := timefn.Period{Start: jan1, End: jan1.Add(24 * time.Hour)}
+	to := timefn.Period{Start: feb1, End: feb1.Add(time.Hour)}
+
+	periods := []timefn.Period{
+		{Start: jan1, End: jan1.Add(12 * time.Hour)},
+		{Start: jan1.Add(18 * time.Hour), End: jan1.Add(30 * time.Hour)},
+		{Start: jan1.Add(6 * time.Hour)},
+	}
+
+	want := []timefn.Period{
+		{Start: feb1, End: feb1.Add(30 * time.Minute)},
+		{Start: feb1.Add(45 * time.Minute), End: feb1.Add(75 * time.Minute)},
+		{Start: feb1.Add(15 * time.Minute)},
+	}
+
+	if got := timefn.Rescale(periods, from, to); !slices.Equal(got, want) {
+		t.Errorf("Rescale() = %v, want %v", got, want)
+	}
+
+	if got := timefn.Rescale(periods, timefn.Period{Start: jan1, End: jan1}, to); got != nil {
+		t.Errorf("Rescale() with an invalid reference frame should return nil; got %v", got)
+	}
+}
+
+func TestTotalDuration(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return jan1.Add(time.Duration(h) * time.Hour) }
+
+	periods := []timefn.Period{
+		{Start: hour(0), End: hour(2)},
+		{Start: hour(1), End: hour(3)},
+		{Start: hour(6), End: hour(5)},
+		{Start: hour(10)},
+		{},
+	}
+
+	if got, want := timefn.TotalDuration(periods), 4*time.Hour; got != want {
+		t.Errorf("TotalDuration() = %v, want %v", got, want)
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return jan1.Add(time.Duration(h) * time.Hour) }
+	bounds := timefn.Period{Start: hour(0), End: hour(10)}
+
+	tests := []struct {
+		name        string
+		bounds      timefn.Period
+		periods     []timefn.Period
+		wantCovered time.Duration
+		wantRatio   float64
+	}{
+		{
+			name:        "no periods",
+			bounds:      bounds,
+			wantCovered: 0,
+			wantRatio:   0,
+		},
+		{
+			name:   "overlapping and clipped periods",
+			bounds: bounds,
+			periods: []timefn.Period{
+				{Start: hour(-5), End: hour(1)},
+				{Start: hour(3), End: hour(5)},
+				{Start: hour(4), End: hour(6)},
+				{Start: hour(9), End: hour(20)},
+			},
+			wantCovered: 5 * time.Hour,
+			wantRatio:   0.5,
+		},
+		{
+			name:        "fully covered",
+			bounds:      bounds,
+			periods:     []timefn.Period{{Start: hour(-1)}},
+			wantCovered: 10 * time.Hour,
+			wantRatio:   1,
+		},
+		{
+			name:        "invalid bounds",
+			bounds:      timefn.Period{Start: hour(1)},
+			periods:     []timefn.Period{{Start: hour(1), End: hour(2)}},
+			wantCovered: 0,
+			wantRatio:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			covered, ratio := timefn.Coverage(tt.bounds, tt.periods)
+			if covered != tt.wantCovered || ratio != tt.wantRatio {
+				t.Errorf("Coverage() = (%v, %v), want (%v, %v)", covered, ratio, tt.wantCovered, tt.wantRatio)
+			}
+		})
+	}
+}
+
+func TestPeriod_Cut_many(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	year := timefn.Period{Start: jan1, End: jan1.AddDate(1, 0, 0)}
+
+	var cuts []timefn.Period
+	for d := year.Start; d.Before(year.End); d = d.AddDate(0, 0, 1) {
+		cuts = append(cuts, timefn.Period{Start: d.Add(18 * time.Hour), End: d.Add(32 * time.Hour)})
+	}
+
+	got := year.Cut(cuts...)
+
+	if len(got) != 365 {
+		t.Fatalf("expected 365 remaining periods; got %d", len(got))
+	}
+
+	if got[0].Duration() != 18*time.Hour {
+		t.Errorf("expected first remaining period to be 18 hours; got %s (%s)", got[0].Duration(), got[0])
+	}
+
+	for i, p := range got[1:] {
+		if p.Duration() != 10*time.Hour {
+			t.Errorf("expected remaining period #%d to be 10 hours; got %s (%s)", i+1, p.Duration(), p)
+		}
+	}
+}
+
+func TestPeriod_Cut_openBothSides(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan3 := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	jan5 := time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	p := timefn.Period{Start: jan1, End: jan5}
+	if got := p.Cut(timefn.Period{End: jan3}, timefn.Period{Start: jan1}); got != nil {
+		t.Errorf("expected open cuts that cover everything to remove the period; got %v", got)
+	}
+}
+
+// benchmarkLocations returns the locations that the period benchmarks run in.
+// UTC takes the fast path, while "UTC+0" is a fixed zone with the same offset
+// that measures the generic path for the same instants.
+func benchmarkLocations(b *testing.B) []*time.Location {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		b.Fatalf("load location: %v", err)
+	}
+	return []*time.Location{time.UTC, time.FixedZone("UTC+0", 0), berlin}
+}
+
+func BenchmarkPeriod_Cut(b *testing.B) {
+	for _, loc := range benchmarkLocations(b) {
+		jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, loc)
+		year := timefn.Period{Start: jan1, End: jan1.AddDate(1, 0, 0)}
+		r := rand.New(rand.NewSource(1))
+
+		cuts := make([]timefn.Period, 5000)
+		for i := range cuts {
+			start := year.Random(r)
+			cuts[i] = timefn.Period{Start: start, End: start.Add(time.Duration(r.Int63n(int64(2 * time.Hour))))}
+		}
+
+		b.Run(loc.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				year.Cut(cuts...)
+			}
+		})
+	}
+}
+
+func benchmarkPeriods(loc *time.Location) ([]timefn.Period, []time.Time) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, loc)
+	r := rand.New(rand.NewSource(1))
+
+	periods := make([]timefn.Period, 1000)
+	times := make([]time.Time, len(periods))
+	for i := range periods {
+		start := jan1.Add(time.Duration(r.Int63n(int64(365 * 24 * time.Hour))))
+		periods[i] = timefn.Period{Start: start, End: start.Add(time.Duration(r.Int63n(int64(48 * time.Hour))))}
+		times[i] = jan1.Add(time.Duration(r.Int63n(int64(365 * 24 * time.Hour))))
+	}
+
+	return periods, times
+}
+
+func BenchmarkPeriod_Contains(b *testing.B) {
+	for _, loc := range benchmarkLocations(b) {
+		periods, times := benchmarkPeriods(loc)
+		b.Run(loc.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				periods[i%len(periods)].Contains(times[i%len(times)])
+			}
+		})
+	}
+}
+
+func BenchmarkPeriod_OverlapsWith(b *testing.B) {
+	for _, loc := range benchmarkLocations(b) {
+		periods, _ := benchmarkPeriods(loc)
+		b.Run(loc.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				periods[i%len(periods)].OverlapsWith(periods[(i+1)%len(periods)])
+			}
+		})
+	}
+}
+
+func TestPeriod_utcFastPath(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	// The results for UTC periods, which take the fast path, must match the
+	// results for the same instants in another location.
+	periods, times := benchmarkPeriods(time.UTC)
+	in := func(p timefn.Period) timefn.Period {
+		return timefn.Period{Start: p.Start.In(berlin), End: p.End.In(berlin)}
+	}
+
+	for i, p := range periods {
+		q := periods[(i+1)%len(periods)]
+		tm := times[i]
+
+		if got, want := p.Contains(tm), in(p).Contains(tm.In(berlin)); got != want {
+			t.Errorf("%v.Contains(%v) = %v; want %v", p, tm, got, want)
+		}
+		if got, want := p.ContainsInclusive(p.End), in(p).ContainsInclusive(p.End.In(berlin)); got != want {
+			t.Errorf("%v.ContainsInclusive(%v) = %v; want %v", p, p.End, got, want)
+		}
+		for _, step := range []time.Duration{0, time.Nanosecond, time.Hour, -time.Hour} {
+			if got, want := p.OverlapsWithStep(step, q), in(p).OverlapsWithStep(step, in(q)); got != want {
+				t.Errorf("%v.OverlapsWithStep(%v, %v) = %v; want %v", p, step, q, got, want)
+			}
+		}
+	}
+
+	// Cut takes the fast path if the period and all periods to cut are UTC.
+	for i := 0; i+10 <= len(periods); i += 10 {
+		p := timefn.Period{Start: periods[i].Start, End: periods[i].Start.Add(30 * 24 * time.Hour)}
+		cuts := append([]timefn.Period{
+			{Start: p.Start, End: p.Start},
+			{Start: p.End, End: p.End.Add(time.Hour)},
+			periods[i],
+			periods[i],
+		}, periods[i+1:i+10]...)
+
+		berlinCuts := make([]timefn.Period, len(cuts))
+		for j, c := range cuts {
+			berlinCuts[j] = in(c)
+		}
+
+		zero := timefn.Period{Start: p.Start, End: p.Start}
+		for _, p := range []timefn.Period{p, zero} {
+			got, want := p.Cut(cuts...), in(p).Cut(berlinCuts...)
+			if !slices.EqualFunc(got, want, func(a, b timefn.Period) bool { return a.EqualWithin(b, 0) }) {
+				t.Errorf("%v.Cut(%v) = %v; want %v", p, cuts, got, want)
+			}
+		}
+	}
+
+	// Times outside of the fast path range and open periods fall back.
+	old := timefn.Period{Start: time.Date(1500, time.January, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2500, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	if !old.Contains(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("%v should contain the year 2000", old)
+	}
+	if !(timefn.Period{Start: old.Start}).OverlapsWith(periods[0]) {
+		t.Errorf("open period should overlap with %v", periods[0])
+	}
+}
@@ -0,0 +1,201 @@
+package timefn
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// periodFuncs are the functions that are available in period formats, see
+// [Period.FormatAs]. Their boundary arguments are either a [time.Time] or an
+// [infBoundary].
+var periodFuncs = template.FuncMap{
+	"format": func(b any, layout string) (string, error) {
+		switch b := b.(type) {
+		case time.Time:
+			return b.Format(layout), nil
+		case infBoundary:
+			return b.String(), nil
+		default:
+			return "", fmt.Errorf("format: expected a period boundary; got %T", b)
+		}
+	},
+	"inLocation": func(b any, name string) (any, error) {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return b, err
+		}
+		if t, ok := b.(time.Time); ok {
+			return t.In(loc), nil
+		}
+		return b, nil
+	},
+	"utc": func(b any) any {
+		if t, ok := b.(time.Time); ok {
+			return t.UTC()
+		}
+		return b
+	},
+	"duration": func(data periodTemplateData) string {
+		if data.OpenStart() || data.OpenEnd() {
+			return "inf"
+		}
+		return FormatDuration(data.Period.Duration())
+	},
+	"formatLocale": func(b any, tag, layout string) (string, error) {
+		t, ok := b.(time.Time)
+		if !ok {
+			return fmt.Sprint(b), nil
+		}
+		l, ok := LookupLocale(tag)
+		if !ok {
+			return "", fmt.Errorf("unknown locale %q", tag)
+		}
+		return l.Format(t, layout), nil
+	},
+}
+
+// periodTemplateData is the data that period formats are executed with. It
+// embeds the formatted [Period], so that its methods remain available within
+// formats. Start and End are the [time.Time] boundaries of the period, or an
+// [infBoundary] if the respective boundary is open.
+type periodTemplateData struct {
+	Period
+	Start, End any
+}
+
+// execute executes the period format tpl with the period.
+func (p Period) execute(tpl *template.Template) string {
+	data := periodTemplateData{Period: p, Start: p.Start, End: p.End}
+	if p.OpenStart() {
+		data.Start = infBoundary("-inf")
+	}
+	if p.OpenEnd() {
+		data.End = infBoundary("+inf")
+	}
+
+	var buf strings.Builder
+	if err := tpl.Execute(&buf, data); err != nil {
+		return fmt.Sprintf("<failed to format period: %s>", err)
+	}
+
+	return buf.String()
+}
+
+// infBoundary is the template representation of an open period boundary,
+// which prints as infinity.
+type infBoundary string
+
+func (b infBoundary) String() string {
+	return string(b)
+}
+
+var (
+	namedFormatsMux sync.RWMutex
+	namedFormats    = make(map[string]*template.Template)
+)
+
+// RegisterPeriodFormat registers the period format tmpl under the given name,
+// so that periods can be formatted using [Period.FormatNamed]. The format is
+// parsed once at registration, see [Period.FormatAs] for its syntax. Registering
+// a format under an existing name replaces it. RegisterPeriodFormat is safe for
+// concurrent use.
+func RegisterPeriodFormat(name, tmpl string) error {
+	tpl, err := template.New(name).Funcs(periodFuncs).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("parse period format %q: %w", name, err)
+	}
+
+	namedFormatsMux.Lock()
+	defer namedFormatsMux.Unlock()
+	namedFormats[name] = tpl
+
+	return nil
+}
+
+// FormatNamed formats the period using the format registered under the given
+// name, see [RegisterPeriodFormat]. If no format is registered under the name,
+// or if an error occurs during formatting, it returns a string representation
+// of the error message.
+func (p Period) FormatNamed(name string) string {
+	namedFormatsMux.RLock()
+	tpl, ok := namedFormats[name]
+	namedFormatsMux.RUnlock()
+
+	if !ok {
+		return fmt.Sprintf("<failed to format period: unknown format %q>", name)
+	}
+
+	return p.execute(tpl)
+}
+
+// FormatLayout formats the period by formatting its start using startLayout
+// and its end using endLayout, joined by sep. The layouts are standard
+// [time.Time.Format] layouts; if endLayout is empty, startLayout is used for
+// both boundaries. Open boundaries are printed as "-inf" and "+inf". Unlike
+// [Period.FormatAs], FormatLayout does not execute a template, so it is safe
+// to use with layouts from configuration or user input.
+func (p Period) FormatLayout(startLayout, endLayout, sep string) string {
+	if endLayout == "" {
+		endLayout = startLayout
+	}
+
+	start, end := "-inf", "+inf"
+	if !p.OpenStart() {
+		start = p.Start.Format(startLayout)
+	}
+	if !p.OpenEnd() {
+		end = p.End.Format(endLayout)
+	}
+
+	return start + sep + end
+}
+
+// FormatDates formats the dates that the period covers for display, omitting
+// the components that are shared between its start and end:
+//
+//	"Jan 3, 2024"                 a single day
+//	"Jan 3–7, 2024"               days within a month
+//	"Jan 28 – Feb 2, 2024"        days within a year
+//	"Dec 30, 2023 – Jan 2, 2024"  days across years
+//
+// The dates are those of the wall clock in the location of p.Start. If the
+// period ends at the first instant of a day, as returned by [Unit.Period] or
+// [StartOfDay], that day is not covered and the previous day is shown as the
+// end. Open boundaries are printed as "-inf" and "+inf", so the zero Period,
+// which has neither boundary, is printed as "-inf – +inf".
+func (p Period) FormatDates() string {
+	if p.unbounded() {
+		return "-inf – +inf"
+	}
+	if p.OpenStart() {
+		return "-inf – " + p.End.Format("Jan 2, 2006")
+	}
+	if p.OpenEnd() {
+		return p.Start.Format("Jan 2, 2006") + " – +inf"
+	}
+
+	start := p.Start
+	end := p.End.In(start.Location())
+
+	y, m, d := end.Date()
+	if end.After(start) && end.Equal(firstInstantOf(y, m, d, end.Location())) {
+		end = end.Add(-time.Nanosecond)
+	}
+
+	sy, sm, sd := start.Date()
+	ey, em, ed := end.Date()
+
+	switch {
+	case sy != ey:
+		return start.Format("Jan 2, 2006") + " – " + end.Format("Jan 2, 2006")
+	case sm != em:
+		return start.Format("Jan 2") + " – " + end.Format("Jan 2, 2006")
+	case sd != ed:
+		return fmt.Sprintf("%s %d–%d, %d", start.Format("Jan"), sd, ed, sy)
+	default:
+		return start.Format("Jan 2, 2006")
+	}
+}
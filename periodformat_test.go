@@ -0,0 +1,298 @@
+package timefn_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestPeriod_FormatAs_funcs(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.January, 2, 12, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		format string
+		want   string
+	}{
+		{
+			name:   "format",
+			period: p,
+			format: `{{ format .Start "2006-01-02" }} -> {{ format .End "2006-01-02 15:04" }}`,
+			want:   "2023-01-01 -> 2023-01-02 12:00",
+		},
+		{
+			name:   "inLocation",
+			period: p,
+			format: `{{ format (inLocation .Start "Europe/Berlin") "2006-01-02 15:04 MST" }}`,
+			want:   "2023-01-01 01:00 CET",
+		},
+		{
+			name:   "utc",
+			period: p.In(time.FixedZone("X", 3600)),
+			format: `{{ format (utc .Start) "15:04" }}`,
+			want:   "00:00",
+		},
+		{
+			name:   "duration",
+			period: p,
+			format: `{{ duration . }}`,
+			want:   timefn.FormatDuration(36 * time.Hour),
+		},
+		{
+			name:   "open boundaries",
+			period: timefn.Period{End: p.End},
+			format: `{{ format (inLocation .Start "Europe/Berlin") "2006" }} / {{ duration . }}`,
+			want:   "-inf / inf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.FormatAs(tt.format); got != tt.want {
+				t.Errorf("FormatAs(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_FormatAs_periodMethods(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	format := `{{ .Start.Year }} {{ .Years }} {{ .IsZero }} {{ .InYear 2023 }} {{ len .Dates }}`
+	want := "2022 [2022 2023] false true 2"
+
+	if got := p.FormatAs(format); got != want {
+		t.Errorf("FormatAs(%q) = %q, want %q", format, got, want)
+	}
+}
+
+func TestPeriod_FormatAs_boundaries(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.January, 2, 12, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		period timefn.Period
+		format string
+		want   string
+	}{
+		{period: p, format: `{{ .End.Sub .Start }}`, want: "36h0m0s"},
+		{period: p, format: `{{ if .Start.Before .End }}before{{ end }}`, want: "before"},
+		{period: p, format: `{{ .Start.Equal .End }}`, want: "false"},
+		{period: p, format: `{{ .Start.Format "2006-01-02" }}`, want: "2023-01-01"},
+		{period: p, format: `{{ .Start }}`, want: p.Start.String()},
+		{period: timefn.Period{Start: p.Start}, format: `{{ .Start.Year }} -> {{ .End }}`, want: "2023 -> +inf"},
+		{period: timefn.Period{End: p.End}, format: `{{ .Start }} -> {{ format .End "2006" }}`, want: "-inf -> 2023"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.period.FormatAs(tt.format); got != tt.want {
+			t.Errorf("FormatAs(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestPeriod_FormatAs_unknownLocation(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := p.FormatAs(`{{ inLocation .Start "Nowhere/Foo" }}`)
+	if !strings.HasPrefix(got, "<failed to format period:") {
+		t.Errorf("FormatAs() = %q, want a formatting error", got)
+	}
+}
+
+func TestPeriod_FormatNamed(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	if err := timefn.RegisterPeriodFormat("test-days", `{{ format .Start "2006-01-02" }}..{{ format .End "2006-01-02" }}`); err != nil {
+		t.Fatalf("RegisterPeriodFormat() failed: %v", err)
+	}
+
+	if got, want := p.FormatNamed("test-days"), "2023-01-01..2023-01-02"; got != want {
+		t.Errorf("FormatNamed() = %q, want %q", got, want)
+	}
+
+	if err := timefn.RegisterPeriodFormat("test-days", `{{ format .Start "Jan 2" }}`); err != nil {
+		t.Fatalf("RegisterPeriodFormat() failed: %v", err)
+	}
+
+	if got, want := p.FormatNamed("test-days"), "Jan 1"; got != want {
+		t.Errorf("FormatNamed() after re-registration = %q, want %q", got, want)
+	}
+
+	if got := p.FormatNamed("test-unknown"); !strings.HasPrefix(got, "<failed to format period:") {
+		t.Errorf("FormatNamed() with unknown name = %q, want a formatting error", got)
+	}
+
+	if err := timefn.RegisterPeriodFormat("test-invalid", "{{ .Start"); err == nil {
+		t.Errorf("RegisterPeriodFormat() with invalid template should fail")
+	}
+}
+
+func TestPeriod_FormatLayout(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2023, time.January, 1, 17, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		period      timefn.Period
+		startLayout string
+		endLayout   string
+		sep         string
+		want        string
+	}{
+		{
+			name:        "same layout",
+			period:      timefn.Period{Start: start, End: end},
+			startLayout: "2006-01-02",
+			sep:         " – ",
+			want:        "2023-01-01 – 2023-01-01",
+		},
+		{
+			name:        "different layouts",
+			period:      timefn.Period{Start: start, End: end},
+			startLayout: "Jan 2, 15:04",
+			endLayout:   "15:04",
+			sep:         "-",
+			want:        "Jan 1, 09:00-17:30",
+		},
+		{
+			name:        "open start",
+			period:      timefn.Period{End: end},
+			startLayout: "15:04",
+			sep:         " to ",
+			want:        "-inf to 17:30",
+		},
+		{
+			name:        "open end",
+			period:      timefn.Period{Start: start},
+			startLayout: "15:04",
+			sep:         " to ",
+			want:        "09:00 to +inf",
+		},
+		{
+			name:        "template syntax is not executed",
+			period:      timefn.Period{Start: start, End: end},
+			startLayout: "{{ .Start }}",
+			sep:         " ",
+			want:        "{{ .Start }} {{ .Start }}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.FormatLayout(tt.startLayout, tt.endLayout, tt.sep); got != tt.want {
+				t.Errorf("FormatLayout() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_FormatDates(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		want   string
+	}{
+		{
+			name: "single day",
+			period: timefn.Period{
+				Start: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2024, time.January, 4, 0, 0, 0, 0, time.UTC),
+			},
+			want: "Jan 3, 2024",
+		},
+		{
+			name: "within a day",
+			period: timefn.Period{
+				Start: time.Date(2024, time.January, 3, 9, 0, 0, 0, time.UTC),
+				End:   time.Date(2024, time.January, 3, 17, 0, 0, 0, time.UTC),
+			},
+			want: "Jan 3, 2024",
+		},
+		{
+			name: "shared month",
+			period: timefn.Period{
+				Start: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC),
+			},
+			want: "Jan 3–7, 2024",
+		},
+		{
+			name: "inclusive end",
+			period: timefn.Period{
+				Start: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC),
+				End:   timefn.EndOfDay(time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)),
+			},
+			want: "Jan 3–7, 2024",
+		},
+		{
+			name: "shared year",
+			period: timefn.Period{
+				Start: time.Date(2024, time.January, 28, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2024, time.February, 3, 0, 0, 0, 0, time.UTC),
+			},
+			want: "Jan 28 – Feb 2, 2024",
+		},
+		{
+			name: "across years",
+			period: timefn.Period{
+				Start: time.Date(2023, time.December, 30, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC),
+			},
+			want: "Dec 30, 2023 – Jan 2, 2024",
+		},
+		{
+			name: "end in another location",
+			period: timefn.Period{
+				Start: time.Date(2024, time.January, 3, 0, 0, 0, 0, berlin),
+				End:   time.Date(2024, time.January, 6, 23, 0, 0, 0, time.UTC),
+			},
+			want: "Jan 3–6, 2024",
+		},
+		{
+			name:   "open end",
+			period: timefn.Period{Start: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC)},
+			want:   "Jan 3, 2024 – +inf",
+		},
+		{
+			name:   "open start",
+			period: timefn.Period{End: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC)},
+			want:   "-inf – Jan 3, 2024",
+		},
+		{
+			name:   "zero",
+			period: timefn.Period{},
+			want:   "-inf – +inf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.FormatDates(); got != tt.want {
+				t.Errorf("FormatDates() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
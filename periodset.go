@@ -0,0 +1,119 @@
+package timefn
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PeriodSet is an immutable set of instants, represented by sorted periods
+// that neither overlap nor touch each other. Use [NewPeriodSet] to create one.
+// The zero value is the empty set. Because a PeriodSet is never modified,
+// copies of it can be shared freely; use a [SyncPeriodSet] to share a set that
+// is replaced by another goroutine.
+type PeriodSet struct {
+	periods []Period
+}
+
+// NewPeriodSet returns the [PeriodSet] that covers all of the given periods.
+// Overlapping and adjacent periods are merged using [Merge]; inverted periods
+// are normalized first. Empty and zero-length periods do not contain any
+// instants and are dropped.
+func NewPeriodSet(periods ...Period) PeriodSet {
+	normalized := make([]Period, 0, len(periods))
+	for _, p := range periods {
+		p = p.Normalize()
+		if p.IsZero() || (!p.Start.IsZero() && p.Start.Equal(p.End)) {
+			continue
+		}
+		normalized = append(normalized, p)
+	}
+
+	merged := Merge(normalized, MergeInPlace())
+	if len(merged) == 0 {
+		return PeriodSet{}
+	}
+
+	return PeriodSet{periods: merged}
+}
+
+// Periods returns a copy of the sorted, non-overlapping periods of the set.
+func (s PeriodSet) Periods() []Period {
+	return slices.Clone(s.periods)
+}
+
+// Len returns the number of periods in the set.
+func (s PeriodSet) Len() int {
+	return len(s.periods)
+}
+
+// IsEmpty returns whether the set does not contain any instants.
+func (s PeriodSet) IsEmpty() bool {
+	return len(s.periods) == 0
+}
+
+// Equal returns whether both sets contain the same instants.
+func (s PeriodSet) Equal(other PeriodSet) bool {
+	return slices.EqualFunc(s.periods, other.periods, func(a, b Period) bool {
+		return a.Start.Equal(b.Start) && a.End.Equal(b.End)
+	})
+}
+
+// Contains returns whether t is contained in one of the periods of the set.
+// It runs in O(log n) time for n periods.
+func (s PeriodSet) Contains(t time.Time) bool {
+	i := s.search(t)
+	return i < len(s.periods) && s.periods[i].Contains(t)
+}
+
+// OverlapsWith returns whether p overlaps with one of the periods of the set.
+// It runs in O(log n) time for n periods.
+func (s PeriodSet) OverlapsWith(p Period) bool {
+	if p.IsZero() {
+		return false
+	}
+	start, _ := p.bounds()
+	i := s.search(start)
+	return i < len(s.periods) && s.periods[i].OverlapsWith(p)
+}
+
+// search returns the index of the first period that ends after t.
+func (s PeriodSet) search(t time.Time) int {
+	return sort.Search(len(s.periods), func(i int) bool {
+		_, end := s.periods[i].bounds()
+		return end.After(t)
+	})
+}
+
+// Union returns the set of instants that are contained in s or other.
+func (s PeriodSet) Union(other PeriodSet) PeriodSet {
+	return NewPeriodSet(append(slices.Clip(s.periods), other.periods...)...)
+}
+
+// Difference returns the set of instants that are contained in s but not in
+// other.
+func (s PeriodSet) Difference(other PeriodSet) PeriodSet {
+	return NewPeriodSet(Subtract(s.periods, other.periods)...)
+}
+
+// Intersection returns the set of instants that are contained in both s and
+// other.
+func (s PeriodSet) Intersection(other PeriodSet) PeriodSet {
+	return NewPeriodSet(Intersect(s.periods, other.periods)...)
+}
+
+// String returns the periods of the set separated by commas and enclosed in
+// braces.
+func (s PeriodSet) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, p := range s.periods {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(p.String())
+	}
+	b.WriteByte('}')
+	return b.String()
+}
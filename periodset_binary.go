@@ -0,0 +1,199 @@
+package timefn
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// periodSetMagic identifies the binary format of [SavePeriodSet].
+const periodSetMagic = "TFPS"
+
+// periodSetVersion is the current version of the binary format of
+// [SavePeriodSet].
+const periodSetVersion = 1
+
+const (
+	periodSetOpenStart = 1 << iota
+	periodSetOpenEnd
+)
+
+// ErrInvalidPeriodSet is returned by [LoadPeriodSet] if the input is not a
+// valid encoding of a [PeriodSet].
+var ErrInvalidPeriodSet = errors.New("invalid period set encoding")
+
+// SavePeriodSet writes the set to w using a compact, versioned binary format.
+// The boundaries of the set are stored as varint-encoded deltas to their
+// predecessor, in the coarsest unit that represents all of them exactly, so
+// dense sets of periods take only a few bytes per period. Locations are not
+// stored; [LoadPeriodSet] returns all boundaries in UTC.
+//
+// The format starts with the magic bytes "TFPS" and a version byte, followed by
+// a flags byte, the unit of the deltas, the number of periods and the
+// boundaries.
+func SavePeriodSet(w io.Writer, set PeriodSet) error {
+	// A period that is open at both ends keeps far boundaries, see
+	// [Period.reopened]. They are stored as flags as well, because their
+	// distance does not fit into a delta.
+	var flags byte
+	if set.Len() > 0 {
+		if first := set.periods[0]; first.Start.IsZero() || first.Start.Equal(farPast) {
+			flags |= periodSetOpenStart
+		}
+		if last := set.periods[set.Len()-1]; last.End.IsZero() || last.End.Equal(farFuture) {
+			flags |= periodSetOpenEnd
+		}
+	}
+
+	boundaries := make([]time.Time, 0, 2*set.Len())
+	for i, p := range set.periods {
+		if i > 0 || flags&periodSetOpenStart == 0 {
+			boundaries = append(boundaries, p.Start)
+		}
+		if i < set.Len()-1 || flags&periodSetOpenEnd == 0 {
+			boundaries = append(boundaries, p.End)
+		}
+	}
+
+	deltas := make([]time.Duration, 0, len(boundaries))
+	for i := 1; i < len(boundaries); i++ {
+		delta := boundaries[i].Sub(boundaries[i-1])
+		if delta < 0 || !boundaries[i-1].Add(delta).Equal(boundaries[i]) {
+			return fmt.Errorf("encode boundary %v: distance to previous boundary is out of range", boundaries[i])
+		}
+		deltas = append(deltas, delta)
+	}
+
+	unit := deltaUnit(deltas)
+
+	buf := make([]byte, 0, 16+len(deltas)*2)
+	buf = append(buf, periodSetMagic...)
+	buf = append(buf, periodSetVersion, flags)
+	buf = binary.AppendUvarint(buf, uint64(unit))
+	buf = binary.AppendUvarint(buf, uint64(set.Len()))
+
+	if len(boundaries) > 0 {
+		buf = binary.AppendVarint(buf, boundaries[0].Unix())
+		buf = binary.AppendUvarint(buf, uint64(boundaries[0].Nanosecond()))
+	}
+
+	for _, delta := range deltas {
+		buf = binary.AppendUvarint(buf, uint64(delta/unit))
+	}
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// deltaUnit returns the coarsest unit in which all deltas can be represented
+// exactly. The first boundary is stored with full precision.
+func deltaUnit(deltas []time.Duration) time.Duration {
+	units := [...]time.Duration{time.Minute, time.Second, time.Millisecond, time.Microsecond}
+
+outer:
+	for _, unit := range units {
+		for _, d := range deltas {
+			if d%unit != 0 {
+				continue outer
+			}
+		}
+		return unit
+	}
+
+	return time.Nanosecond
+}
+
+// LoadPeriodSet reads a [PeriodSet] that was written by [SavePeriodSet]. It
+// returns an error that wraps [ErrInvalidPeriodSet] if the input is malformed
+// or was written using an unsupported version of the format.
+func LoadPeriodSet(r io.Reader) (PeriodSet, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		buffered := bufio.NewReader(r)
+		r, br = buffered, buffered
+	}
+
+	header := make([]byte, len(periodSetMagic)+2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return PeriodSet{}, fmt.Errorf("%w: read header: %w", ErrInvalidPeriodSet, err)
+	}
+
+	if string(header[:len(periodSetMagic)]) != periodSetMagic {
+		return PeriodSet{}, fmt.Errorf("%w: missing magic bytes", ErrInvalidPeriodSet)
+	}
+
+	if version := header[len(periodSetMagic)]; version != periodSetVersion {
+		return PeriodSet{}, fmt.Errorf("%w: unsupported version %d", ErrInvalidPeriodSet, version)
+	}
+	flags := header[len(periodSetMagic)+1]
+
+	unit, err := binary.ReadUvarint(br)
+	if err != nil || unit == 0 || unit > uint64(time.Hour) {
+		return PeriodSet{}, fmt.Errorf("%w: invalid delta unit", ErrInvalidPeriodSet)
+	}
+
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return PeriodSet{}, fmt.Errorf("%w: read length: %w", ErrInvalidPeriodSet, err)
+	}
+
+	if n == 0 {
+		return PeriodSet{}, nil
+	}
+
+	// Every period takes at least one byte, so the length cannot be trusted
+	// for preallocation beyond a sane limit.
+	periods := make([]Period, 0, minUint64(n, 1<<16))
+
+	var prev time.Time
+	for i := uint64(0); i < n; i++ {
+		var p Period
+		for j, b := range [2]*time.Time{&p.Start, &p.End} {
+			if i == 0 && j == 0 && flags&periodSetOpenStart != 0 {
+				*b = farPast
+				continue
+			}
+			if i == n-1 && j == 1 && flags&periodSetOpenEnd != 0 {
+				*b = farFuture
+				continue
+			}
+
+			if prev.IsZero() {
+				sec, err := binary.ReadVarint(br)
+				if err != nil {
+					return PeriodSet{}, fmt.Errorf("%w: read boundary: %w", ErrInvalidPeriodSet, err)
+				}
+				nsec, err := binary.ReadUvarint(br)
+				if err != nil || nsec >= uint64(time.Second) {
+					return PeriodSet{}, fmt.Errorf("%w: read boundary: invalid nanoseconds", ErrInvalidPeriodSet)
+				}
+				*b = time.Unix(sec, int64(nsec)).UTC()
+				prev = *b
+				continue
+			}
+
+			delta, err := binary.ReadUvarint(br)
+			if err != nil {
+				return PeriodSet{}, fmt.Errorf("%w: read boundary: %w", ErrInvalidPeriodSet, err)
+			}
+			if delta > (1<<63-1)/unit {
+				return PeriodSet{}, fmt.Errorf("%w: boundary delta out of range", ErrInvalidPeriodSet)
+			}
+			*b = prev.Add(time.Duration(delta * unit))
+			prev = *b
+		}
+		periods = append(periods, p)
+	}
+
+	return NewPeriodSet(periods...), nil
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
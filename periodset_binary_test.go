@@ -0,0 +1,163 @@
+package timefn_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestSavePeriodSet_roundTrip(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 123, time.UTC)
+
+	tests := []struct {
+		name string
+		set  timefn.PeriodSet
+	}{
+		{
+			name: "empty",
+			set:  timefn.PeriodSet{},
+		},
+		{
+			name: "closed",
+			set: timefn.NewPeriodSet(
+				timefn.Period{Start: jan1, End: jan1.Add(time.Hour)},
+				timefn.Period{Start: jan1.Add(2 * time.Hour), End: jan1.Add(3 * time.Hour)},
+			),
+		},
+		{
+			name: "open start",
+			set: timefn.NewPeriodSet(
+				timefn.Period{End: jan1},
+				timefn.Period{Start: jan1.Add(2 * time.Hour), End: jan1.Add(3 * time.Hour)},
+			),
+		},
+		{
+			name: "open end",
+			set: timefn.NewPeriodSet(
+				timefn.Period{Start: jan1, End: jan1.Add(time.Hour)},
+				timefn.Period{Start: jan1.Add(2 * time.Hour)},
+			),
+		},
+		{
+			name: "open start and end",
+			set: timefn.NewPeriodSet(
+				timefn.Period{End: jan1},
+				timefn.Period{Start: jan1.Add(2 * time.Hour)},
+			),
+		},
+		{
+			name: "single period open at both ends",
+			set: timefn.NewPeriodSet(
+				timefn.Period{End: jan1},
+				timefn.Period{Start: jan1},
+			),
+		},
+		{
+			name: "before 1970",
+			set: timefn.NewPeriodSet(
+				timefn.Period{Start: time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), End: jan1},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := timefn.SavePeriodSet(&buf, tt.set); err != nil {
+				t.Fatalf("SavePeriodSet() failed: %v", err)
+			}
+
+			loaded, err := timefn.LoadPeriodSet(&buf)
+			if err != nil {
+				t.Fatalf("LoadPeriodSet() failed: %v", err)
+			}
+
+			if !loaded.Equal(tt.set) {
+				t.Errorf("LoadPeriodSet() = %v, want %v", loaded, tt.set)
+			}
+
+			for _, p := range loaded.Periods() {
+				if (!p.Start.IsZero() && p.Start.Location() != time.UTC) || (!p.End.IsZero() && p.End.Location() != time.UTC) {
+					t.Errorf("expected loaded period %v to be in UTC", p)
+				}
+			}
+		})
+	}
+}
+
+func TestSavePeriodSet_size(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var periods []timefn.Period
+	current := start
+	for i := 0; i < 1000; i++ {
+		current = current.Add(time.Duration(r.Intn(120)+1) * time.Minute)
+		end := current.Add(time.Duration(r.Intn(60)+1) * time.Minute)
+		periods = append(periods, timefn.Period{Start: current, End: end})
+		current = end
+	}
+	set := timefn.NewPeriodSet(periods...)
+
+	var bin bytes.Buffer
+	if err := timefn.SavePeriodSet(&bin, set); err != nil {
+		t.Fatalf("SavePeriodSet() failed: %v", err)
+	}
+
+	js, err := json.Marshal(set.Periods())
+	if err != nil {
+		t.Fatalf("marshal periods: %v", err)
+	}
+
+	if bin.Len()*10 > len(js) {
+		t.Errorf("expected binary encoding to be at least 10x smaller than JSON; got %d bytes vs %d bytes", bin.Len(), len(js))
+	}
+}
+
+func TestLoadPeriodSet_invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{name: "empty", input: nil},
+		{name: "wrong magic", input: []byte("XXXX\x01\x00\x00")},
+		{name: "unsupported version", input: []byte("TFPS\x02\x00\x00")},
+		{name: "truncated", input: []byte("TFPS\x01\x00\x02\x02")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := timefn.LoadPeriodSet(bytes.NewReader(tt.input))
+			if !errors.Is(err, timefn.ErrInvalidPeriodSet) {
+				t.Errorf("expected ErrInvalidPeriodSet; got %v", err)
+			}
+		})
+	}
+}
+
+func BenchmarkLoadPeriodSet(b *testing.B) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	periods := make([]timefn.Period, 10000)
+	for i := range periods {
+		s := start.Add(time.Duration(i) * time.Hour)
+		periods[i] = timefn.Period{Start: s, End: s.Add(30 * time.Minute)}
+	}
+
+	var buf bytes.Buffer
+	if err := timefn.SavePeriodSet(&buf, timefn.NewPeriodSet(periods...)); err != nil {
+		b.Fatalf("SavePeriodSet() failed: %v", err)
+	}
+	data := buf.Bytes()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := timefn.LoadPeriodSet(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
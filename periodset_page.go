@@ -0,0 +1,63 @@
+package timefn
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+)
+
+// Page returns up to limit periods of the set that sort after the period
+// after, as defined by [ComparePeriods]. To paginate over a set, pass the zero
+// Period to get the first page, and the last period of a page to get the next
+// one; an empty result marks the end. Because the cursor is a period rather
+// than an offset, pages are deterministic and do not skip or repeat periods
+// that were not changed, even if the set is modified between requests. Use
+// [EncodePageCursor] to pass the cursor through an API. Page runs in
+// O(log n + limit) time and returns nil if limit is not positive.
+func (s PeriodSet) Page(after Period, limit int) []Period {
+	if limit <= 0 {
+		return nil
+	}
+
+	i := 0
+	if !after.IsZero() {
+		i = sort.Search(len(s.periods), func(i int) bool {
+			return ComparePeriods(s.periods[i], after) > 0
+		})
+	}
+
+	end := len(s.periods)
+	if end-i > limit {
+		end = i + limit
+	}
+
+	if i >= end {
+		return nil
+	}
+
+	return append([]Period(nil), s.periods[i:end]...)
+}
+
+// EncodePageCursor encodes the period as an opaque, URL-safe cursor for
+// [PeriodSet.Page], typically the last period of a page. The zero Period is
+// encoded as an empty cursor, which denotes the first page.
+func EncodePageCursor(p Period) string {
+	text, _ := p.MarshalText()
+	return base64.RawURLEncoding.EncodeToString(text)
+}
+
+// DecodePageCursor decodes a cursor that was encoded by [EncodePageCursor].
+// An empty cursor decodes into the zero Period.
+func DecodePageCursor(cursor string) (Period, error) {
+	text, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Period{}, fmt.Errorf("decode page cursor %q: %w", cursor, err)
+	}
+
+	var p Period
+	if err := p.UnmarshalText(text); err != nil {
+		return Period{}, fmt.Errorf("decode page cursor %q: %w", cursor, err)
+	}
+
+	return p, nil
+}
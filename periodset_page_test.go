@@ -0,0 +1,84 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestPeriodSet_Page(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: at(start), End: at(end)} }
+
+	set := timefn.NewPeriodSet(p(8, 9), p(0, 1), p(2, 3), p(4, 5), p(6, 7))
+
+	var (
+		pages  [][]timefn.Period
+		cursor string
+	)
+	for {
+		after, err := timefn.DecodePageCursor(cursor)
+		if err != nil {
+			t.Fatalf("DecodePageCursor(%q) failed: %v", cursor, err)
+		}
+
+		page := set.Page(after, 2)
+		if len(page) == 0 {
+			break
+		}
+		pages = append(pages, page)
+		cursor = timefn.EncodePageCursor(page[len(page)-1])
+	}
+
+	want := [][]timefn.Period{{p(0, 1), p(2, 3)}, {p(4, 5), p(6, 7)}, {p(8, 9)}}
+	if len(pages) != len(want) {
+		t.Fatalf("got %d pages; want %d: %v", len(pages), len(want), pages)
+	}
+	for i := range pages {
+		if len(pages[i]) != len(want[i]) {
+			t.Fatalf("page %d = %v; want %v", i, pages[i], want[i])
+		}
+		for j := range pages[i] {
+			if !pages[i][j].Start.Equal(want[i][j].Start) || !pages[i][j].End.Equal(want[i][j].End) {
+				t.Errorf("page %d = %v; want %v", i, pages[i], want[i])
+			}
+		}
+	}
+}
+
+func TestPeriodSet_Page_modified(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: at(start), End: at(end)} }
+
+	set := timefn.NewPeriodSet(p(0, 1), p(2, 3), p(4, 5))
+	first := set.Page(timefn.Period{}, 2)
+
+	// A period that is inserted before the cursor does not shift the next page.
+	set = set.Union(timefn.NewPeriodSet(timefn.Period{Start: at(1).Add(15 * time.Minute), End: at(1).Add(30 * time.Minute)}))
+
+	next := set.Page(first[len(first)-1], 2)
+	if len(next) != 1 || !next[0].Start.Equal(at(4)) {
+		t.Errorf("next page = %v; want [%v]", next, p(4, 5))
+	}
+
+	if got := set.Page(timefn.Period{}, 0); got != nil {
+		t.Errorf("Page() with limit 0 = %v; want nil", got)
+	}
+}
+
+func TestDecodePageCursor(t *testing.T) {
+	p := timefn.Period{Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)}
+
+	got, err := timefn.DecodePageCursor(timefn.EncodePageCursor(p))
+	if err != nil {
+		t.Fatalf("DecodePageCursor() failed: %v", err)
+	}
+	if !got.Start.Equal(p.Start) || !got.End.IsZero() {
+		t.Errorf("DecodePageCursor() = %v; want %v", got, p)
+	}
+
+	if _, err := timefn.DecodePageCursor("not a cursor!"); err == nil {
+		t.Errorf("DecodePageCursor() with invalid cursor should fail")
+	}
+}
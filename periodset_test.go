@@ -0,0 +1,155 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestNewPeriodSet(t *testing.T) {
+	jan := func(d, h int) time.Time {
+		return time.Date(2023, time.January, d, h, 0, 0, 0, time.UTC)
+	}
+
+	set := timefn.NewPeriodSet(
+		timefn.Period{Start: jan(3, 0), End: jan(4, 0)},
+		timefn.Period{Start: jan(2, 0), End: jan(1, 0)},
+		timefn.Period{Start: jan(1, 12), End: jan(2, 12)},
+		timefn.Period{Start: jan(5, 0), End: jan(5, 0)},
+		timefn.Period{},
+		timefn.Period{Start: jan(4, 0), End: jan(4, 6)},
+	)
+
+	want := []timefn.Period{
+		{Start: jan(1, 0), End: jan(2, 12)},
+		{Start: jan(3, 0), End: jan(4, 6)},
+	}
+
+	if got := set.Periods(); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Periods() = %v, want %v", got, want)
+	}
+
+	if set.Len() != 2 || set.IsEmpty() {
+		t.Errorf("expected set with 2 periods; got %v", set)
+	}
+
+	if !timefn.NewPeriodSet().IsEmpty() {
+		t.Errorf("expected empty set")
+	}
+}
+
+func TestPeriodSet_Equal(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jan2 := jan1.AddDate(0, 0, 1)
+
+	a := timefn.NewPeriodSet(timefn.Period{Start: jan1, End: jan2})
+	b := timefn.NewPeriodSet(timefn.Period{Start: jan1.In(time.FixedZone("X", 3600)), End: jan2})
+	c := timefn.NewPeriodSet(timefn.Period{Start: jan1, End: jan2.Add(time.Second)})
+
+	if !a.Equal(b) {
+		t.Errorf("expected %v to equal %v", a, b)
+	}
+
+	if a.Equal(c) {
+		t.Errorf("expected %v not to equal %v", a, c)
+	}
+}
+
+func TestPeriodSet_operations(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC)
+	}
+
+	a := timefn.NewPeriodSet(timefn.Period{Start: at(8), End: at(12)}, timefn.Period{Start: at(14), End: at(16)})
+	b := timefn.NewPeriodSet(timefn.Period{Start: at(10), End: at(15)})
+
+	tests := []struct {
+		name string
+		got  timefn.PeriodSet
+		want timefn.PeriodSet
+	}{
+		{
+			name: "Union",
+			got:  a.Union(b),
+			want: timefn.NewPeriodSet(timefn.Period{Start: at(8), End: at(16)}),
+		},
+		{
+			name: "Difference",
+			got:  a.Difference(b),
+			want: timefn.NewPeriodSet(timefn.Period{Start: at(8), End: at(10)}, timefn.Period{Start: at(15), End: at(16)}),
+		},
+		{
+			name: "Intersection",
+			got:  a.Intersection(b),
+			want: timefn.NewPeriodSet(timefn.Period{Start: at(10), End: at(12)}, timefn.Period{Start: at(14), End: at(15)}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodSet_Contains(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC)
+	}
+
+	set := timefn.NewPeriodSet(
+		timefn.Period{End: at(2)},
+		timefn.Period{Start: at(8), End: at(12)},
+		timefn.Period{Start: at(14)},
+	)
+
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{t: at(0), want: true},
+		{t: at(2), want: false},
+		{t: at(8), want: true},
+		{t: at(12), want: false},
+		{t: at(13), want: false},
+		{t: at(20), want: true},
+	}
+
+	for _, tt := range tests {
+		if got := set.Contains(tt.t); got != tt.want {
+			t.Errorf("Contains(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+
+	if set.OverlapsWith(timefn.Period{Start: at(12), End: at(14)}) {
+		t.Errorf("expected 12:00-14:00 not to overlap")
+	}
+
+	if !set.OverlapsWith(timefn.Period{Start: at(11), End: at(13)}) {
+		t.Errorf("expected 11:00-13:00 to overlap")
+	}
+}
+
+func TestPeriodSet_immutable(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC)
+	}
+
+	set := timefn.NewPeriodSet(timefn.Period{Start: at(8), End: at(12)})
+	snapshot := set
+
+	set = set.Union(timefn.NewPeriodSet(timefn.Period{Start: at(14), End: at(16)}))
+	set = set.Difference(timefn.NewPeriodSet(timefn.Period{Start: at(9), End: at(10)}))
+
+	want := timefn.NewPeriodSet(timefn.Period{Start: at(8), End: at(12)})
+	if !snapshot.Equal(want) {
+		t.Errorf("expected snapshot %v to be unchanged; got %v", want, snapshot)
+	}
+
+	if set.Equal(snapshot) {
+		t.Errorf("expected set to differ from snapshot")
+	}
+}
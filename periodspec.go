@@ -0,0 +1,196 @@
+package timefn
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// specUnits maps the unit names of period specs to units.
+var specUnits = map[string]Unit{
+	"minute":  UnitMinute,
+	"hour":    UnitHour,
+	"day":     UnitDay,
+	"week":    UnitWeek,
+	"month":   UnitMonth,
+	"quarter": UnitQuarter,
+	"year":    UnitYear,
+}
+
+// PeriodSpec is a description of a period that is resolved to a concrete
+// [Period] at runtime, such as "last-7-days" or "month-to-date". It is meant
+// to be used in configuration structs: PeriodSpec implements
+// [encoding.TextUnmarshaler], so it can be decoded from JSON, YAML or
+// environment variables, and the spec is validated when it is decoded. The
+// following specs are supported:
+//
+//	"today", "yesterday", "tomorrow"       the respective day
+//	"this-month", "last-week", "next-year" the current, previous or next unit
+//	"month-to-date", "year-to-date"        from the start of the unit up to now
+//	"last-7-days", "last-2-hours"          the rolling period up to now
+//	"2024", "2024-Q1", "2024-03"           the calendar year, quarter or month
+//	"2024-03-15"                           the calendar day
+//	"2023-01-01T00:00:00Z/P1M"             an ISO 8601 interval, see [ParsePeriod]
+//	"now-7d/now", "startOfQuarter/now"     a range expression, see [EvalRangeExpr]
+//
+// Units are "day", "week", "month", "quarter" and "year"; to-date and rolling
+// specs also accept "hour", and rolling specs also accept "minute". Weeks are
+// ISO 8601 weeks that start on Monday. The zero PeriodSpec is empty and cannot
+// be resolved.
+type PeriodSpec struct {
+	spec string
+}
+
+// ParsePeriodSpec parses and validates a [PeriodSpec].
+func ParsePeriodSpec(s string) (PeriodSpec, error) {
+	spec := PeriodSpec{spec: strings.TrimSpace(s)}
+
+	// Validate against an arbitrary time that is not at the start of a unit,
+	// so that specs like "startOfDay/now" resolve to a valid period.
+	if _, err := spec.Resolve(time.Date(2000, time.February, 15, 12, 30, 30, 0, time.UTC), time.UTC); err != nil {
+		return PeriodSpec{}, err
+	}
+	return spec, nil
+}
+
+// IsZero returns whether the spec is empty.
+func (s PeriodSpec) IsZero() bool {
+	return s.spec == ""
+}
+
+// String returns the spec as it was parsed.
+func (s PeriodSpec) String() string {
+	return s.spec
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (s PeriodSpec) MarshalText() ([]byte, error) {
+	return []byte(s.spec), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] using
+// [ParsePeriodSpec].
+func (s *PeriodSpec) UnmarshalText(text []byte) error {
+	parsed, err := ParsePeriodSpec(string(text))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// Resolve resolves the spec to a concrete period relative to now, using the
+// calendar of the location loc. ISO 8601 intervals resolve to the same period
+// regardless of now and loc.
+func (s PeriodSpec) Resolve(now time.Time, loc *time.Location) (Period, error) {
+	if s.spec == "" {
+		return Period{}, fmt.Errorf("resolve period spec: empty spec")
+	}
+	if loc == nil {
+		return Period{}, fmt.Errorf("resolve period spec %q: nil location", s.spec)
+	}
+
+	now = now.In(loc)
+
+	if p, ok := s.resolveRelative(now); ok {
+		return p, nil
+	}
+
+	if p, ok := s.resolveCalendar(loc); ok {
+		return p, nil
+	}
+
+	if strings.Contains(s.spec, "/") {
+		if p, err := ParsePeriod(s.spec); err == nil {
+			return p, nil
+		}
+	}
+
+	if p, err := EvalRangeExpr(s.spec, now, loc); err == nil {
+		return p, nil
+	}
+
+	return Period{}, fmt.Errorf("resolve period spec %q: unknown spec", s.spec)
+}
+
+// resolveRelative resolves the named specs that are relative to now.
+func (s PeriodSpec) resolveRelative(now time.Time) (Period, bool) {
+	switch s.spec {
+	case "today":
+		return UnitDay.Period(now), true
+	case "yesterday":
+		return UnitDay.Period(UnitDay.Add(now, -1)), true
+	case "tomorrow":
+		return UnitDay.Period(UnitDay.Add(now, 1)), true
+	}
+
+	if name, ok := strings.CutSuffix(s.spec, "-to-date"); ok {
+		u, ok := specUnits[name]
+		if !ok || u < UnitHour {
+			return Period{}, false
+		}
+		return Period{Start: u.Start(now), End: now}, true
+	}
+
+	parts := strings.Split(s.spec, "-")
+	switch {
+	case len(parts) == 2 && (parts[0] == "this" || parts[0] == "last" || parts[0] == "next"):
+		u, ok := specUnits[parts[1]]
+		if !ok || u < UnitDay {
+			return Period{}, false
+		}
+		var shift int
+		switch parts[0] {
+		case "last":
+			shift = -1
+		case "next":
+			shift = 1
+		}
+		return u.Period(u.Add(u.Start(now), shift)), true
+
+	case len(parts) == 3 && parts[0] == "last":
+		n, err := strconv.Atoi(parts[1])
+		if err != nil || n <= 0 {
+			return Period{}, false
+		}
+		u, ok := specUnits[strings.TrimSuffix(parts[2], "s")]
+		if !ok {
+			return Period{}, false
+		}
+		return Period{Start: u.Add(now, -n), End: now}, true
+	}
+
+	return Period{}, false
+}
+
+// resolveCalendar resolves specs of calendar years, quarters, months and
+// days, such as "2024-Q1" or "2024-03".
+func (s PeriodSpec) resolveCalendar(loc *time.Location) (Period, bool) {
+	if len(s.spec) == 4 {
+		year, err := strconv.Atoi(s.spec)
+		if err != nil {
+			return Period{}, false
+		}
+		return UnitYear.Period(time.Date(year, time.January, 1, 12, 0, 0, 0, loc)), true
+	}
+
+	if year, quarter, ok := strings.Cut(s.spec, "-Q"); ok && len(year) == 4 && len(quarter) == 1 {
+		y, err := strconv.Atoi(year)
+		if err != nil || quarter < "1" || quarter > "4" {
+			return Period{}, false
+		}
+		m := time.Month(3*int(quarter[0]-'1') + 1)
+		return UnitQuarter.Period(time.Date(y, m, 1, 12, 0, 0, 0, loc)), true
+	}
+
+	if t, err := time.Parse("2006-01", s.spec); err == nil {
+		return UnitMonth.Period(time.Date(t.Year(), t.Month(), 1, 12, 0, 0, 0, loc)), true
+	}
+
+	if d, err := ParseDate(s.spec); err == nil {
+		return d.Period(loc), true
+	}
+
+	return Period{}, false
+}
@@ -0,0 +1,101 @@
+package timefn_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestPeriodSpec_Resolve(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	now := time.Date(2024, time.March, 15, 14, 30, 0, 0, berlin)
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, berlin) }
+
+	tests := []struct {
+		spec string
+		want timefn.Period
+	}{
+		{spec: "today", want: timefn.Period{Start: day(2024, time.March, 15), End: day(2024, time.March, 16)}},
+		{spec: "yesterday", want: timefn.Period{Start: day(2024, time.March, 14), End: day(2024, time.March, 15)}},
+		{spec: "tomorrow", want: timefn.Period{Start: day(2024, time.March, 16), End: day(2024, time.March, 17)}},
+		{spec: "this-week", want: timefn.Period{Start: day(2024, time.March, 11), End: day(2024, time.March, 18)}},
+		{spec: "last-month", want: timefn.Period{Start: day(2024, time.February, 1), End: day(2024, time.March, 1)}},
+		{spec: "next-quarter", want: timefn.Period{Start: day(2024, time.April, 1), End: day(2024, time.July, 1)}},
+		{spec: "month-to-date", want: timefn.Period{Start: day(2024, time.March, 1), End: now}},
+		{spec: "year-to-date", want: timefn.Period{Start: day(2024, time.January, 1), End: now}},
+		{spec: "last-7-days", want: timefn.Period{Start: time.Date(2024, time.March, 8, 14, 30, 0, 0, berlin), End: now}},
+		{spec: "last-1-hour", want: timefn.Period{Start: time.Date(2024, time.March, 15, 13, 30, 0, 0, berlin), End: now}},
+		{spec: "2024", want: timefn.Period{Start: day(2024, time.January, 1), End: day(2025, time.January, 1)}},
+		{spec: "2024-Q2", want: timefn.Period{Start: day(2024, time.April, 1), End: day(2024, time.July, 1)}},
+		{spec: "2024-03", want: timefn.Period{Start: day(2024, time.March, 1), End: day(2024, time.April, 1)}},
+		{spec: "2024-03-31", want: timefn.Period{Start: day(2024, time.March, 31), End: day(2024, time.April, 1)}},
+		{
+			spec: "2023-01-01T00:00:00Z/P1M",
+			want: timefn.Period{Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{spec: "now-7d/d/now", want: timefn.Period{Start: day(2024, time.March, 8), End: now}},
+		{spec: "startOfQuarter/now", want: timefn.Period{Start: day(2024, time.January, 1), End: now}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			spec, err := timefn.ParsePeriodSpec(tt.spec)
+			if err != nil {
+				t.Fatalf("ParsePeriodSpec(%q) failed: %v", tt.spec, err)
+			}
+
+			got, err := spec.Resolve(now, berlin)
+			if err != nil {
+				t.Fatalf("Resolve() failed: %v", err)
+			}
+
+			if !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) {
+				t.Errorf("Resolve() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePeriodSpec_invalid(t *testing.T) {
+	for _, spec := range []string{"", "someday", "last-0-days", "last-x-days", "this-hour", "minute-to-date", "2024-Q5", "2024-13", "now-"} {
+		if _, err := timefn.ParsePeriodSpec(spec); err == nil {
+			t.Errorf("ParsePeriodSpec(%q) should fail", spec)
+		}
+	}
+}
+
+func TestPeriodSpec_json(t *testing.T) {
+	var cfg struct {
+		Range timefn.PeriodSpec `json:"range"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"range":"month-to-date"}`), &cfg); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if got := cfg.Range.String(); got != "month-to-date" {
+		t.Errorf("unmarshaled spec = %q; want %q", got, "month-to-date")
+	}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(b) != `{"range":"month-to-date"}` {
+		t.Errorf("marshaled spec = %s", b)
+	}
+
+	if err := json.Unmarshal([]byte(`{"range":"someday"}`), &cfg); err == nil {
+		t.Errorf("unmarshaling an invalid spec should fail")
+	}
+
+	if _, err := (timefn.PeriodSpec{}).Resolve(time.Now(), time.UTC); err == nil {
+		t.Errorf("resolving the zero spec should fail")
+	}
+}
@@ -0,0 +1,267 @@
+package timefn
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rangeUnits maps the unit letters of range expressions to units.
+var rangeUnits = map[string]Unit{
+	"s": UnitSecond,
+	"m": UnitMinute,
+	"h": UnitHour,
+	"d": UnitDay,
+	"w": UnitWeek,
+	"M": UnitMonth,
+	"y": UnitYear,
+}
+
+// anchorUnits maps the unit names of anchors to units.
+var anchorUnits = map[string]Unit{
+	"Day":     UnitDay,
+	"Week":    UnitWeek,
+	"Month":   UnitMonth,
+	"Quarter": UnitQuarter,
+	"Year":    UnitYear,
+}
+
+// rangeOperand is a boundary of a range expression.
+type rangeOperand struct {
+	expr  string
+	round Unit
+}
+
+// EvalRangeExpr evaluates a Grafana-style relative time range expression
+// against now in the location loc. An expression consists of one or two
+// operands separated by "/". Each operand starts with "now" or a named anchor,
+// optionally followed by offsets such as "-7d" or "+1h", and may be rounded to
+// the unit that contains it by appending "/" and a unit:
+//
+//	"now-7d"                          the last 7 days, up to now
+//	"now-7d/now"                      the same, with an explicit end
+//	"now/M"                           this month
+//	"now-1y/y"                        last year
+//	"now-1d/d/now"                    from the start of yesterday up to now
+//	"startOfQuarter/now"              this quarter, up to now
+//	"startOfLastMonth/endOfLastMonth" last month
+//
+// Anchors are named "startOf" or "endOf", optionally followed by "Last" or
+// "Next", followed by "Day", "Week", "Month", "Quarter" or "Year", such as
+// "startOfQuarter" or "endOfLastMonth". An anchor resolves to the first
+// instant or, like [EndOfMonth], to the last nanosecond of the respective unit
+// containing now.
+//
+// The units are "s", "m", "h", "d", "w" (ISO weeks starting on Monday), "M"
+// and "y". Offsets of hours and below are added as elapsed time, larger ones
+// on the calendar in loc, see [Unit.Add]. A single rounded operand evaluates
+// to the unit period that contains it; a single unrounded operand evaluates
+// to the period from the operand to now. If two operands are given, a rounded
+// start is rounded down to the start of its unit and a rounded end is rounded
+// up to the end of its unit. EvalRangeExpr returns an error if the expression
+// is malformed or does not evaluate to a valid period.
+func EvalRangeExpr(s string, now time.Time, loc *time.Location) (Period, error) {
+	if loc == nil {
+		return Period{}, fmt.Errorf("evaluate range expression %q: nil location", s)
+	}
+
+	operands, err := parseRangeOperands(s)
+	if err != nil {
+		return Period{}, fmt.Errorf("evaluate range expression %q: %w", s, err)
+	}
+
+	now = now.In(loc)
+
+	bounds := make([]time.Time, len(operands))
+	for i, op := range operands {
+		if bounds[i], err = evalRangeOperand(op.expr, now); err != nil {
+			return Period{}, fmt.Errorf("evaluate range expression %q: %w", s, err)
+		}
+	}
+
+	var out Period
+	switch {
+	case len(operands) == 2:
+		out.Start, out.End = bounds[0], bounds[1]
+		if u := operands[0].round; u != 0 {
+			out.Start = u.Start(out.Start)
+		}
+		if u := operands[1].round; u != 0 {
+			out.End = u.Period(out.End).End
+		}
+	case operands[0].round != 0:
+		out = operands[0].round.Period(bounds[0])
+	default:
+		out = Period{Start: bounds[0], End: now}
+	}
+
+	if err := out.Validate(); err != nil {
+		return Period{}, fmt.Errorf("evaluate range expression %q: %w", s, err)
+	}
+
+	return out, nil
+}
+
+// parseRangeOperands splits a range expression into its operands.
+func parseRangeOperands(s string) ([]rangeOperand, error) {
+	var operands []rangeOperand
+	for _, part := range strings.Split(strings.TrimSpace(s), "/") {
+		part = strings.TrimSpace(part)
+
+		if u, ok := rangeUnits[part]; ok {
+			if len(operands) == 0 || operands[len(operands)-1].round != 0 {
+				return nil, fmt.Errorf("unexpected rounding unit %q", part)
+			}
+			operands[len(operands)-1].round = u
+			continue
+		}
+
+		if len(operands) == 2 {
+			return nil, fmt.Errorf("too many operands")
+		}
+		operands = append(operands, rangeOperand{expr: part})
+	}
+
+	if len(operands) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	return operands, nil
+}
+
+// EvalInstantExpr evaluates a single operand of a range expression, such as
+// "now-1h", "startOfQuarter-1d" or "endOfLastMonth", to an instant. The
+// operand is evaluated against now in the location loc and may be rounded
+// down to the start of a unit, as in "now-1d/d". See [EvalRangeExpr] for the
+// syntax.
+func EvalInstantExpr(s string, now time.Time, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return time.Time{}, fmt.Errorf("evaluate instant expression %q: nil location", s)
+	}
+
+	operands, err := parseRangeOperands(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("evaluate instant expression %q: %w", s, err)
+	}
+	if len(operands) != 1 {
+		return time.Time{}, fmt.Errorf("evaluate instant expression %q: expected a single operand", s)
+	}
+
+	t, err := evalRangeOperand(operands[0].expr, now.In(loc))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("evaluate instant expression %q: %w", s, err)
+	}
+
+	if u := operands[0].round; u != 0 {
+		t = u.Start(t)
+	}
+
+	return t, nil
+}
+
+// ResolveRangeExpr evaluates the range expression s against the current time
+// as returned by [Now], see [EvalRangeExpr].
+func ResolveRangeExpr(s string, loc *time.Location) (Period, error) {
+	return EvalRangeExpr(s, Now(), loc)
+}
+
+// ResolveRangeExprContext is like [ResolveRangeExpr], but evaluates s against
+// the current time as returned by [NowFromContext].
+func ResolveRangeExprContext(ctx context.Context, s string, loc *time.Location) (Period, error) {
+	return EvalRangeExpr(s, NowFromContext(ctx), loc)
+}
+
+// ResolveInstantExpr evaluates the instant expression s against the current
+// time as returned by [Now], see [EvalInstantExpr].
+func ResolveInstantExpr(s string, loc *time.Location) (time.Time, error) {
+	return EvalInstantExpr(s, Now(), loc)
+}
+
+// ResolveInstantExprContext is like [ResolveInstantExpr], but evaluates s
+// against the current time as returned by [NowFromContext].
+func ResolveInstantExprContext(ctx context.Context, s string, loc *time.Location) (time.Time, error) {
+	return EvalInstantExpr(s, NowFromContext(ctx), loc)
+}
+
+// evalRangeOperand evaluates an unrounded operand such as "now-7d" against now.
+func evalRangeOperand(expr string, now time.Time) (time.Time, error) {
+	base, rest := expr, ""
+	if i := strings.IndexAny(expr, "+-"); i >= 0 {
+		base, rest = expr[:i], expr[i:]
+	}
+
+	t := now
+	if base != "now" {
+		var ok bool
+		if t, ok = resolveAnchor(base, now); !ok {
+			return time.Time{}, fmt.Errorf("operand %q must start with \"now\" or an anchor", expr)
+		}
+	}
+
+	for rest != "" {
+		sign := 1
+		switch rest[0] {
+		case '+':
+		case '-':
+			sign = -1
+		default:
+			return time.Time{}, fmt.Errorf("invalid offset %q in operand %q", rest, expr)
+		}
+		rest = rest[1:]
+
+		i := strings.IndexFunc(rest, func(r rune) bool { return r < '0' || r > '9' })
+		switch {
+		case i == 0:
+			return time.Time{}, fmt.Errorf("missing amount in operand %q", expr)
+		case i < 0:
+			return time.Time{}, fmt.Errorf("missing unit in operand %q", expr)
+		}
+
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid amount in operand %q: %w", expr, err)
+		}
+
+		u, ok := rangeUnits[rest[i:i+1]]
+		if !ok {
+			return time.Time{}, fmt.Errorf("unknown unit %q in operand %q", rest[i:i+1], expr)
+		}
+		rest = rest[i+1:]
+
+		t = u.Add(t, sign*n)
+	}
+
+	return t, nil
+}
+
+// resolveAnchor resolves the named anchor, such as "endOfLastMonth", against
+// now.
+func resolveAnchor(name string, now time.Time) (time.Time, bool) {
+	rest, end := strings.CutPrefix(name, "endOf")
+	if !end {
+		var ok bool
+		if rest, ok = strings.CutPrefix(name, "startOf"); !ok {
+			return time.Time{}, false
+		}
+	}
+
+	var shift int
+	if r, ok := strings.CutPrefix(rest, "Last"); ok {
+		rest, shift = r, -1
+	} else if r, ok := strings.CutPrefix(rest, "Next"); ok {
+		rest, shift = r, 1
+	}
+
+	u, ok := anchorUnits[rest]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	p := u.Period(u.Add(u.Start(now), shift))
+	if end {
+		return p.End.Add(-time.Nanosecond), true
+	}
+	return p.Start, true
+}
@@ -0,0 +1,195 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestEvalRangeExpr(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	now := time.Date(2023, time.March, 15, 14, 30, 0, 0, berlin)
+
+	tests := []struct {
+		expr    string
+		want    timefn.Period
+		wantErr bool
+	}{
+		{
+			expr: "now-7d",
+			want: timefn.Period{Start: time.Date(2023, time.March, 8, 14, 30, 0, 0, berlin), End: now},
+		},
+		{
+			expr: "now-7d/now",
+			want: timefn.Period{Start: time.Date(2023, time.March, 8, 14, 30, 0, 0, berlin), End: now},
+		},
+		{
+			expr: "now/M",
+			want: timefn.Period{Start: time.Date(2023, time.March, 1, 0, 0, 0, 0, berlin), End: time.Date(2023, time.April, 1, 0, 0, 0, 0, berlin)},
+		},
+		{
+			expr: "now-1y/y",
+			want: timefn.Period{Start: time.Date(2022, time.January, 1, 0, 0, 0, 0, berlin), End: time.Date(2023, time.January, 1, 0, 0, 0, 0, berlin)},
+		},
+		{
+			expr: "now/w",
+			want: timefn.Period{Start: time.Date(2023, time.March, 13, 0, 0, 0, 0, berlin), End: time.Date(2023, time.March, 20, 0, 0, 0, 0, berlin)},
+		},
+		{
+			expr: "now-1d/d/now",
+			want: timefn.Period{Start: time.Date(2023, time.March, 14, 0, 0, 0, 0, berlin), End: now},
+		},
+		{
+			expr: "now-2d/d / now-1d/d",
+			want: timefn.Period{Start: time.Date(2023, time.March, 13, 0, 0, 0, 0, berlin), End: time.Date(2023, time.March, 15, 0, 0, 0, 0, berlin)},
+		},
+		{
+			expr: "now-1M+2h/h",
+			want: timefn.Period{Start: time.Date(2023, time.February, 15, 16, 0, 0, 0, berlin), End: time.Date(2023, time.February, 15, 17, 0, 0, 0, berlin)},
+		},
+		{
+			expr: "startOfQuarter/now",
+			want: timefn.Period{Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, berlin), End: now},
+		},
+		{
+			expr: "startOfLastMonth/endOfLastMonth",
+			want: timefn.Period{Start: time.Date(2023, time.February, 1, 0, 0, 0, 0, berlin), End: time.Date(2023, time.February, 28, 23, 59, 59, 999999999, berlin)},
+		},
+		{
+			expr: "startOfQuarter-1d/d",
+			want: timefn.Period{Start: time.Date(2022, time.December, 31, 0, 0, 0, 0, berlin), End: time.Date(2023, time.January, 1, 0, 0, 0, 0, berlin)},
+		},
+		{
+			expr: "startOfNextWeek/endOfNextWeek/d",
+			want: timefn.Period{Start: time.Date(2023, time.March, 20, 0, 0, 0, 0, berlin), End: time.Date(2023, time.March, 27, 0, 0, 0, 0, berlin)},
+		},
+		{expr: "", wantErr: true},
+		{expr: "startOfFortnight", wantErr: true},
+		{expr: "middleOfMonth", wantErr: true},
+		{expr: "now", wantErr: true},
+		{expr: "now/now", wantErr: true},
+		{expr: "now+1d/now", wantErr: true},
+		{expr: "today", wantErr: true},
+		{expr: "now-d", wantErr: true},
+		{expr: "now-7", wantErr: true},
+		{expr: "now-7x", wantErr: true},
+		{expr: "now/d/d", wantErr: true},
+		{expr: "d", wantErr: true},
+		{expr: "now-2d/now-1d/now", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := timefn.EvalRangeExpr(tt.expr, now, berlin)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("EvalRangeExpr(%q) should fail; got %v", tt.expr, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EvalRangeExpr(%q) failed: %v", tt.expr, err)
+			}
+			if !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) {
+				t.Errorf("EvalRangeExpr(%q) = %v; want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalRangeExpr_location(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	// 23:30 UTC is already the next day in Berlin.
+	now := time.Date(2023, time.March, 14, 23, 30, 0, 0, time.UTC)
+
+	got, err := timefn.EvalRangeExpr("now/d", now, berlin)
+	if err != nil {
+		t.Fatalf("EvalRangeExpr() failed: %v", err)
+	}
+
+	if want := time.Date(2023, time.March, 15, 0, 0, 0, 0, berlin); !got.Start.Equal(want) {
+		t.Errorf("EvalRangeExpr() starts at %v; want %v", got.Start, want)
+	}
+
+	if _, err := timefn.EvalRangeExpr("now/d", now, nil); err == nil {
+		t.Errorf("EvalRangeExpr() with nil location should fail")
+	}
+}
+
+func TestEvalInstantExpr(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	now := time.Date(2023, time.March, 15, 14, 30, 0, 0, berlin)
+
+	tests := []struct {
+		expr    string
+		want    time.Time
+		wantErr bool
+	}{
+		{expr: "now", want: now},
+		{expr: "now-1h", want: time.Date(2023, time.March, 15, 13, 30, 0, 0, berlin)},
+		{expr: "now-1d/d", want: time.Date(2023, time.March, 14, 0, 0, 0, 0, berlin)},
+		{expr: "startOfDay", want: time.Date(2023, time.March, 15, 0, 0, 0, 0, berlin)},
+		{expr: "startOfQuarter-1d", want: time.Date(2022, time.December, 31, 0, 0, 0, 0, berlin)},
+		{expr: "endOfLastMonth", want: time.Date(2023, time.February, 28, 23, 59, 59, 999999999, berlin)},
+		{expr: "endOfYear", want: time.Date(2023, time.December, 31, 23, 59, 59, 999999999, berlin)},
+		{expr: "startOfLastYear+6M", want: time.Date(2022, time.July, 1, 0, 0, 0, 0, berlin)},
+		{expr: "startOfDay/now", wantErr: true},
+		{expr: "endOfLast", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := timefn.EvalInstantExpr(tt.expr, now, berlin)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("EvalInstantExpr(%q) should fail; got %v", tt.expr, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EvalInstantExpr(%q) failed: %v", tt.expr, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("EvalInstantExpr(%q) = %v; want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveRangeExpr(t *testing.T) {
+	defer freezeNow(time.Date(2023, time.March, 15, 14, 30, 0, 0, time.UTC))()
+
+	got, err := timefn.ResolveRangeExpr("startOfLastMonth/endOfLastMonth/d", time.UTC)
+	if err != nil {
+		t.Fatalf("ResolveRangeExpr() failed: %v", err)
+	}
+
+	want := timefn.Period{
+		Start: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if !got.Start.Equal(want.Start) || !got.End.Equal(want.End) {
+		t.Errorf("ResolveRangeExpr() = %v; want %v", got, want)
+	}
+
+	at, err := timefn.ResolveInstantExpr("endOfDay", time.UTC)
+	if err != nil {
+		t.Fatalf("ResolveInstantExpr() failed: %v", err)
+	}
+	if want := time.Date(2023, time.March, 15, 23, 59, 59, 999999999, time.UTC); !at.Equal(want) {
+		t.Errorf("ResolveInstantExpr() = %v; want %v", at, want)
+	}
+}
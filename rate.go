@@ -0,0 +1,41 @@
+package timefn
+
+// RatePerUnit converts a count of events within p into a rate per unit, such as
+// events per day or per month. Units of a day and above are measured with their
+// real calendar lengths in the location of p.Start, so a period that covers
+// all of February counts as exactly one month, and a 23-hour DST day counts as
+// exactly one day. Periods that cover units only partially count the covered
+// fraction of each unit. RatePerUnit returns 0 if p is not valid or empty, or
+// if the unit is unknown.
+func RatePerUnit(count int, p Period, unit Unit) float64 {
+	units := unitsIn(p, unit)
+	if units == 0 {
+		return 0
+	}
+	return float64(count) / units
+}
+
+// unitsIn returns the number of units that fit into p, including fractions of
+// partially covered units.
+func unitsIn(p Period, unit Unit) float64 {
+	if p.Validate() != nil || unit.approx() == 0 {
+		return 0
+	}
+
+	if unit < UnitDay {
+		return float64(p.Duration()) / float64(unit.approx())
+	}
+
+	var n float64
+	for cur := p.Start; cur.Before(p.End); {
+		u := unit.Period(cur)
+		end := u.End
+		if end.After(p.End) {
+			end = p.End
+		}
+		n += float64(end.Sub(cur)) / float64(u.Duration())
+		cur = end
+	}
+
+	return n
+}
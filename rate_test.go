@@ -0,0 +1,72 @@
+package timefn_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestRatePerUnit(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		count int
+		p     timefn.Period
+		unit  timefn.Unit
+		want  float64
+	}{
+		{
+			name:  "per hour",
+			count: 90,
+			p:     timefn.Period{Start: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2023, time.March, 1, 1, 30, 0, 0, time.UTC)},
+			unit:  timefn.UnitHour,
+			want:  60,
+		},
+		{
+			name:  "february is one month",
+			count: 28,
+			p:     timefn.Period{Start: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			unit:  timefn.UnitMonth,
+			want:  28,
+		},
+		{
+			name:  "dst day is one day",
+			count: 23,
+			p:     timefn.Period{Start: time.Date(2023, time.March, 26, 0, 0, 0, 0, berlin), End: time.Date(2023, time.March, 27, 0, 0, 0, 0, berlin)},
+			unit:  timefn.UnitDay,
+			want:  23,
+		},
+		{
+			name:  "partial months",
+			count: 30,
+			p:     timefn.Period{Start: time.Date(2023, time.January, 17, 0, 0, 0, 0, time.UTC), End: time.Date(2023, time.February, 15, 0, 0, 0, 0, time.UTC)},
+			unit:  timefn.UnitMonth,
+			want:  30 / (15.0/31 + 14.0/28),
+		},
+		{
+			name:  "invalid period",
+			count: 10,
+			p:     timefn.Period{Start: time.Date(2023, time.March, 2, 0, 0, 0, 0, time.UTC), End: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			unit:  timefn.UnitDay,
+		},
+		{
+			name:  "unknown unit",
+			count: 10,
+			p:     timefn.Period{Start: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2023, time.March, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.RatePerUnit(tt.count, tt.p, tt.unit); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("RatePerUnit() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
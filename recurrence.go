@@ -0,0 +1,359 @@
+package timefn
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// maxRecurrenceSkips limits the number of consecutive intervals without any
+// occurrence, e.g. because of nonexistent dates like February 30, that a
+// [Recurrence] skips before it gives up.
+const maxRecurrenceSkips = 1000
+
+// Frequency is the unit in which a [Recurrence] repeats.
+type Frequency int
+
+const (
+	// Daily repeats a recurrence every Interval days.
+	Daily Frequency = iota + 1
+
+	// Weekly repeats a recurrence every Interval weeks.
+	Weekly
+
+	// Monthly repeats a recurrence every Interval months.
+	Monthly
+
+	// Yearly repeats a recurrence every Interval years.
+	Yearly
+)
+
+var frequencyNames = [...]string{
+	Daily:   "daily",
+	Weekly:  "weekly",
+	Monthly: "monthly",
+	Yearly:  "yearly",
+}
+
+// String returns the name of the frequency, e.g. "weekly".
+func (f Frequency) String() string {
+	if f < Daily || f > Yearly {
+		return "<unknown frequency>"
+	}
+	return frequencyNames[f]
+}
+
+// Recurrence generates periods that repeat on a daily, weekly, monthly or
+// yearly rule, such as "every 2 weeks starting Jan 3, 9:00-10:30".
+//
+// Occurrences are computed from the Anchor on the wall clock of its location,
+// so they keep their local start and end times across DST transitions. Monthly
+// and yearly occurrences that would fall on a nonexistent date, like February
+// 30, are skipped instead of being moved to the next month.
+type Recurrence struct {
+	// Frequency is the unit in which the recurrence repeats.
+	Frequency Frequency
+
+	// Interval is the number of Frequency units between two occurrences. An
+	// Interval of zero is treated as 1.
+	Interval int
+
+	// Anchor is the first occurrence. All other occurrences are copies of it,
+	// shifted by a multiple of the Interval.
+	Anchor Period
+
+	// Count limits the total number of occurrences, including the Anchor. A
+	// Count of zero means no limit.
+	Count int
+
+	// Until excludes all occurrences that start after it. A zero Until means
+	// no limit.
+	Until time.Time
+
+	// ByDay restricts or expands the occurrences to the given weekdays, like
+	// the BYDAY rule part of RFC 5545. Weekly recurrences occur on each of the
+	// weekdays, in weeks that start on Monday. Monthly and yearly recurrences
+	// occur on each matching weekday of the month or year, or only on the n-th
+	// one if N is non-zero. Daily recurrences skip days on other weekdays.
+	ByDay []NthWeekday
+
+	// ByMonthDay restricts or expands the occurrences to the given days of the
+	// month, like the BYMONTHDAY rule part of RFC 5545. Negative days count
+	// from the end of the month, so -1 is the last day. Monthly and yearly
+	// recurrences occur on each of the days, daily recurrences skip all other
+	// days. ByMonthDay cannot be used with weekly recurrences.
+	ByMonthDay []int
+}
+
+// NthWeekday is a weekday, optionally limited to its n-th occurrence within a
+// month or year. A positive N counts from the start, a negative N from the end,
+// and an N of zero matches every occurrence of the weekday.
+type NthWeekday struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Validate returns an error if the recurrence has an unknown Frequency, a
+// negative Interval or Count, an invalid Anchor or invalid ByDay or ByMonthDay
+// rules.
+func (r Recurrence) Validate() error {
+	if r.Frequency < Daily || r.Frequency > Yearly {
+		return fmt.Errorf("unknown frequency %d", int(r.Frequency))
+	}
+
+	if r.Interval < 0 {
+		return fmt.Errorf("negative interval %d", r.Interval)
+	}
+
+	if r.Count < 0 {
+		return fmt.Errorf("negative count %d", r.Count)
+	}
+
+	if err := r.Anchor.Validate(); err != nil {
+		return fmt.Errorf("anchor: %w", err)
+	}
+
+	for _, d := range r.ByDay {
+		if d.Weekday < time.Sunday || d.Weekday > time.Saturday {
+			return fmt.Errorf("invalid weekday %d", int(d.Weekday))
+		}
+		if d.N != 0 && r.Frequency != Monthly && r.Frequency != Yearly {
+			return fmt.Errorf("nth weekday %d%s requires a monthly or yearly frequency", d.N, d.Weekday)
+		}
+		if d.N < -53 || d.N > 53 {
+			return fmt.Errorf("nth weekday %d%s is out of range", d.N, d.Weekday)
+		}
+	}
+
+	for _, d := range r.ByMonthDay {
+		if d == 0 || d < -31 || d > 31 {
+			return fmt.Errorf("day of month %d is out of range", d)
+		}
+	}
+
+	if len(r.ByMonthDay) > 0 && r.Frequency == Weekly {
+		return fmt.Errorf("days of month cannot be used with a weekly frequency")
+	}
+
+	return nil
+}
+
+// Occurrences returns the occurrences of the recurrence that overlap with the
+// given bounds, in chronological order. An open start of the bounds includes
+// all occurrences from the Anchor on. If the bounds have an open end, the
+// recurrence must be limited by Count or Until; otherwise Occurrences returns
+// nil, as it does for invalid recurrences.
+func (r Recurrence) Occurrences(bounds Period) []Period {
+	if r.Validate() != nil || bounds.IsZero() {
+		return nil
+	}
+
+	if bounds.OpenEnd() && r.Count == 0 && r.Until.IsZero() {
+		return nil
+	}
+
+	var out []Period
+	it := r.Iter()
+	for {
+		p, ok := it.Next()
+		if !ok || (!bounds.End.IsZero() && !p.Start.Before(bounds.End)) {
+			break
+		}
+
+		if p.OverlapsWith(bounds) {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+// Iter returns a [RecurrenceIterator] that yields the occurrences of the
+// recurrence in chronological order, starting at the Anchor. The iterator is
+// unbounded unless the recurrence is limited by Count or Until.
+func (r Recurrence) Iter() *RecurrenceIterator {
+	return &RecurrenceIterator{r: r, valid: r.Validate() == nil}
+}
+
+// occurrencesIn returns the occurrences within the n-th interval after the one
+// that contains the Anchor, in chronological order. Occurrences on nonexistent
+// dates and occurrences before the Anchor are omitted.
+func (r Recurrence) occurrencesIn(n int) []Period {
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	anchor := r.Anchor.Start
+	ay, am, ad := anchor.Date()
+
+	var days []time.Time
+	switch r.Frequency {
+	case Daily:
+		days = []time.Time{time.Date(ay, am, ad+n*interval, 0, 0, 0, 0, time.UTC)}
+	case Weekly:
+		weekStart := WeekISO.StartOfWeek(time.Date(ay, am, ad+7*n*interval, 0, 0, 0, 0, time.UTC))
+		if len(r.ByDay) == 0 {
+			days = []time.Time{weekStart.AddDate(0, 0, (int(anchor.Weekday())+6)%7)}
+		}
+		for _, d := range r.ByDay {
+			days = append(days, weekStart.AddDate(0, 0, (int(d.Weekday)+6)%7))
+		}
+	case Monthly:
+		month := time.Date(ay, am+time.Month(n*interval), 1, 0, 0, 0, 0, time.UTC)
+		days = r.daysIn(month, month.AddDate(0, 1, 0), ad)
+	case Yearly:
+		year := time.Date(ay+n*interval, 1, 1, 0, 0, 0, 0, time.UTC)
+		if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+			if day := time.Date(year.Year(), am, ad, 0, 0, 0, 0, time.UTC); day.Day() == ad {
+				days = []time.Time{day}
+			}
+			break
+		}
+		days = r.daysIn(year, year.AddDate(1, 0, 0), ad)
+	}
+
+	slices.SortFunc(days, time.Time.Compare)
+	days = slices.Compact(days)
+
+	out := make([]Period, 0, len(days))
+	for _, day := range days {
+		if !r.matchesDay(day) {
+			continue
+		}
+
+		start := time.Date(day.Year(), day.Month(), day.Day(), anchor.Hour(), anchor.Minute(), anchor.Second(), anchor.Nanosecond(), anchor.Location())
+		if start.Before(anchor) {
+			continue
+		}
+
+		out = append(out, Period{Start: start, End: shiftWallClock(anchor, r.Anchor.End, start)})
+	}
+
+	return out
+}
+
+// daysIn returns the candidate days between from and to, which span whole
+// months. Without ByDay and ByMonthDay rules, the day of the Anchor is used in
+// each month, unless it does not exist.
+func (r Recurrence) daysIn(from, to time.Time, anchorDay int) []time.Time {
+	var out []time.Time
+
+	if len(r.ByDay) > 0 {
+		for _, d := range r.ByDay {
+			out = append(out, nthWeekdays(from, to, d)...)
+		}
+		return out
+	}
+
+	monthDays := r.ByMonthDay
+	if len(monthDays) == 0 {
+		monthDays = []int{anchorDay}
+	}
+
+	for month := from; month.Before(to); month = month.AddDate(0, 1, 0) {
+		length := DaysInMonth(month.Year(), month.Month())
+		for _, d := range monthDays {
+			if d < 0 {
+				d += length + 1
+			}
+			if d >= 1 && d <= length {
+				out = append(out, month.AddDate(0, 0, d-1))
+			}
+		}
+	}
+
+	return out
+}
+
+// matchesDay returns whether the day passes the ByDay and ByMonthDay rules that
+// restrict, rather than expand, the occurrences of the recurrence.
+func (r Recurrence) matchesDay(day time.Time) bool {
+	if len(r.ByMonthDay) > 0 {
+		length := DaysInMonth(day.Year(), day.Month())
+		if !slices.ContainsFunc(r.ByMonthDay, func(d int) bool {
+			return d == day.Day() || d+length+1 == day.Day()
+		}) {
+			return false
+		}
+	}
+
+	if len(r.ByDay) > 0 && r.Frequency == Daily {
+		return slices.ContainsFunc(r.ByDay, func(d NthWeekday) bool {
+			return d.Weekday == day.Weekday()
+		})
+	}
+
+	return true
+}
+
+// nthWeekdays returns the days in [from, to) that fall on the weekday of d. If
+// d.N is non-zero, only the n-th of these days is returned.
+func nthWeekdays(from, to time.Time, d NthWeekday) []time.Time {
+	first := from.AddDate(0, 0, (int(d.Weekday)-int(from.Weekday())+7)%7)
+
+	var all []time.Time
+	for day := first; day.Before(to); day = day.AddDate(0, 0, 7) {
+		all = append(all, day)
+	}
+
+	switch {
+	case d.N == 0:
+		return all
+	case d.N > 0 && d.N <= len(all):
+		return all[d.N-1 : d.N]
+	case d.N < 0 && -d.N <= len(all):
+		return all[len(all)+d.N : len(all)+d.N+1]
+	default:
+		return nil
+	}
+}
+
+// shiftWallClock returns end moved to the same calendar distance from start
+// that it has from the given anchor, keeping its wall-clock time.
+func shiftWallClock(anchor, end, start time.Time) time.Time {
+	ay, am, ad := anchor.Date()
+	ey, em, ed := end.Date()
+	dayDiff := int(time.Date(ey, em, ed, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+
+	sy, sm, sd := start.Date()
+	return time.Date(sy, sm, sd+dayDiff, end.Hour(), end.Minute(), end.Second(), end.Nanosecond(), end.Location())
+}
+
+// RecurrenceIterator yields the occurrences of a [Recurrence] one by one. Use
+// [Recurrence.Iter] to create one.
+type RecurrenceIterator struct {
+	r       Recurrence
+	valid   bool
+	n       int
+	pending []Period
+	emitted int
+}
+
+// Next returns the next occurrence. It returns false when the recurrence is
+// exhausted or invalid.
+func (it *RecurrenceIterator) Next() (Period, bool) {
+	if !it.valid || (it.r.Count > 0 && it.emitted >= it.r.Count) {
+		return Period{}, false
+	}
+
+	for skips := 0; len(it.pending) == 0; skips++ {
+		if skips >= maxRecurrenceSkips {
+			it.valid = false
+			return Period{}, false
+		}
+		it.pending = it.r.occurrencesIn(it.n)
+		it.n++
+	}
+
+	p := it.pending[0]
+	it.pending = it.pending[1:]
+
+	if !it.r.Until.IsZero() && p.Start.After(it.r.Until) {
+		it.valid = false
+		return Period{}, false
+	}
+
+	it.emitted++
+	return p, true
+}
@@ -0,0 +1,174 @@
+package timefn_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestRecurrence_Occurrences(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	at := func(y int, m time.Month, d, h, min int) time.Time {
+		return time.Date(y, m, d, h, min, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		name   string
+		r      timefn.Recurrence
+		bounds timefn.Period
+		want   []timefn.Period
+	}{
+		{
+			name: "every 2 weeks across DST",
+			r: timefn.Recurrence{
+				Frequency: timefn.Weekly,
+				Interval:  2,
+				Anchor:    timefn.Period{Start: at(2023, time.March, 13, 9, 0), End: at(2023, time.March, 13, 10, 30)},
+			},
+			bounds: timefn.Period{Start: at(2023, time.March, 1, 0, 0), End: at(2023, time.April, 11, 0, 0)},
+			want: []timefn.Period{
+				{Start: at(2023, time.March, 13, 9, 0), End: at(2023, time.March, 13, 10, 30)},
+				{Start: at(2023, time.March, 27, 9, 0), End: at(2023, time.March, 27, 10, 30)},
+				{Start: at(2023, time.April, 10, 9, 0), End: at(2023, time.April, 10, 10, 30)},
+			},
+		},
+		{
+			name: "bounds start after anchor",
+			r: timefn.Recurrence{
+				Frequency: timefn.Daily,
+				Anchor:    timefn.Period{Start: at(2023, time.January, 1, 22, 0), End: at(2023, time.January, 2, 2, 0)},
+			},
+			bounds: timefn.Period{Start: at(2023, time.January, 5, 0, 0), End: at(2023, time.January, 6, 0, 0)},
+			want: []timefn.Period{
+				{Start: at(2023, time.January, 4, 22, 0), End: at(2023, time.January, 5, 2, 0)},
+				{Start: at(2023, time.January, 5, 22, 0), End: at(2023, time.January, 6, 2, 0)},
+			},
+		},
+		{
+			name: "monthly skips nonexistent dates",
+			r: timefn.Recurrence{
+				Frequency: timefn.Monthly,
+				Anchor:    timefn.Period{Start: at(2023, time.January, 31, 9, 0), End: at(2023, time.January, 31, 10, 0)},
+			},
+			bounds: timefn.Period{Start: at(2023, time.January, 1, 0, 0), End: at(2023, time.May, 1, 0, 0)},
+			want: []timefn.Period{
+				{Start: at(2023, time.January, 31, 9, 0), End: at(2023, time.January, 31, 10, 0)},
+				{Start: at(2023, time.March, 31, 9, 0), End: at(2023, time.March, 31, 10, 0)},
+			},
+		},
+		{
+			name: "count",
+			r: timefn.Recurrence{
+				Frequency: timefn.Yearly,
+				Anchor:    timefn.Period{Start: at(2020, time.June, 1, 0, 0), End: at(2020, time.June, 2, 0, 0)},
+				Count:     2,
+			},
+			bounds: timefn.Period{Start: at(2020, time.January, 1, 0, 0)},
+			want: []timefn.Period{
+				{Start: at(2020, time.June, 1, 0, 0), End: at(2020, time.June, 2, 0, 0)},
+				{Start: at(2021, time.June, 1, 0, 0), End: at(2021, time.June, 2, 0, 0)},
+			},
+		},
+		{
+			name: "until",
+			r: timefn.Recurrence{
+				Frequency: timefn.Daily,
+				Interval:  3,
+				Anchor:    timefn.Period{Start: at(2023, time.January, 1, 9, 0), End: at(2023, time.January, 1, 10, 0)},
+				Until:     at(2023, time.January, 7, 9, 0),
+			},
+			bounds: timefn.Period{Start: at(2023, time.January, 1, 0, 0)},
+			want: []timefn.Period{
+				{Start: at(2023, time.January, 1, 9, 0), End: at(2023, time.January, 1, 10, 0)},
+				{Start: at(2023, time.January, 4, 9, 0), End: at(2023, time.January, 4, 10, 0)},
+				{Start: at(2023, time.January, 7, 9, 0), End: at(2023, time.January, 7, 10, 0)},
+			},
+		},
+		{
+			name: "unbounded",
+			r: timefn.Recurrence{
+				Frequency: timefn.Daily,
+				Anchor:    timefn.Period{Start: at(2023, time.January, 1, 9, 0), End: at(2023, time.January, 1, 10, 0)},
+			},
+			bounds: timefn.Period{Start: at(2023, time.January, 1, 0, 0)},
+			want:   nil,
+		},
+		{
+			name: "invalid",
+			r: timefn.Recurrence{
+				Anchor: timefn.Period{Start: at(2023, time.January, 1, 9, 0), End: at(2023, time.January, 1, 10, 0)},
+			},
+			bounds: timefn.Period{Start: at(2023, time.January, 1, 0, 0), End: at(2023, time.February, 1, 0, 0)},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.Occurrences(tt.bounds)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Occurrences() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || !got[i].End.Equal(tt.want[i].End) {
+					t.Errorf("Occurrences()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrence_Iter(t *testing.T) {
+	anchor := timefn.Period{
+		Start: time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, time.February, 29, 13, 0, 0, 0, time.UTC),
+	}
+	r := timefn.Recurrence{Frequency: timefn.Yearly, Anchor: anchor}
+
+	it := r.Iter()
+	var years []int
+	for i := 0; i < 3; i++ {
+		p, ok := it.Next()
+		if !ok {
+			t.Fatalf("expected occurrence %d", i)
+		}
+		years = append(years, p.Start.Year())
+	}
+
+	if want := []int{2024, 2028, 2032}; !reflect.DeepEqual(years, want) {
+		t.Errorf("expected occurrences in %v; got %v", want, years)
+	}
+}
+
+func TestRecurrence_Validate(t *testing.T) {
+	anchor := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name    string
+		r       timefn.Recurrence
+		wantErr bool
+	}{
+		{name: "valid", r: timefn.Recurrence{Frequency: timefn.Daily, Anchor: anchor}},
+		{name: "unknown frequency", r: timefn.Recurrence{Anchor: anchor}, wantErr: true},
+		{name: "negative interval", r: timefn.Recurrence{Frequency: timefn.Daily, Interval: -1, Anchor: anchor}, wantErr: true},
+		{name: "negative count", r: timefn.Recurrence{Frequency: timefn.Daily, Count: -1, Anchor: anchor}, wantErr: true},
+		{name: "invalid anchor", r: timefn.Recurrence{Frequency: timefn.Daily}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.r.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
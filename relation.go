@@ -0,0 +1,145 @@
+package timefn
+
+// Relation is one of the 13 relations of Allen's interval algebra, describing
+// how two periods are positioned relative to each other. Exactly one relation
+// holds between any two valid periods. See [Period.Relation].
+type Relation int
+
+const (
+	// RelationPrecedes means that the period ends before the other starts.
+	RelationPrecedes Relation = iota + 1
+
+	// RelationMeets means that the period ends exactly when the other starts.
+	RelationMeets
+
+	// RelationOverlaps means that the period starts before the other and ends
+	// within it.
+	RelationOverlaps
+
+	// RelationFinishedBy means that the period starts before the other and
+	// both end at the same time.
+	RelationFinishedBy
+
+	// RelationContains means that the period starts before and ends after the
+	// other.
+	RelationContains
+
+	// RelationStarts means that both periods start at the same time and the
+	// period ends before the other.
+	RelationStarts
+
+	// RelationEquals means that both periods start and end at the same time.
+	RelationEquals
+
+	// RelationStartedBy means that both periods start at the same time and the
+	// period ends after the other.
+	RelationStartedBy
+
+	// RelationDuring means that the period starts after and ends before the
+	// other.
+	RelationDuring
+
+	// RelationFinishes means that the period starts after the other and both
+	// end at the same time.
+	RelationFinishes
+
+	// RelationOverlappedBy means that the period starts within the other and
+	// ends after it.
+	RelationOverlappedBy
+
+	// RelationMetBy means that the period starts exactly when the other ends.
+	RelationMetBy
+
+	// RelationPrecededBy means that the period starts after the other ends.
+	RelationPrecededBy
+)
+
+var relationNames = [...]string{
+	RelationPrecedes:     "precedes",
+	RelationMeets:        "meets",
+	RelationOverlaps:     "overlaps",
+	RelationFinishedBy:   "finished by",
+	RelationContains:     "contains",
+	RelationStarts:       "starts",
+	RelationEquals:       "equals",
+	RelationStartedBy:    "started by",
+	RelationDuring:       "during",
+	RelationFinishes:     "finishes",
+	RelationOverlappedBy: "overlapped by",
+	RelationMetBy:        "met by",
+	RelationPrecededBy:   "preceded by",
+}
+
+// String returns the name of the relation, e.g. "overlapped by".
+func (r Relation) String() string {
+	if r < RelationPrecedes || r > RelationPrecededBy {
+		return "<unknown relation>"
+	}
+	return relationNames[r]
+}
+
+// Inverse returns the relation that holds when the periods are swapped, so
+// that a.Relation(b).Inverse() equals b.Relation(a). For example, the inverse
+// of [RelationMeets] is [RelationMetBy]. [RelationEquals] is its own inverse.
+func (r Relation) Inverse() Relation {
+	if r < RelationPrecedes || r > RelationPrecededBy {
+		return r
+	}
+	return RelationPrecededBy + RelationPrecedes - r
+}
+
+// Relation returns the relation of Allen's interval algebra that holds between
+// p and other. Unlike [Period.OverlapsWith], it distinguishes periods that
+// merely meet from periods that overlap. Open boundaries extend a period
+// indefinitely, so two open ends are considered to end at the same time. Both
+// periods should be valid; the result for invalid periods is unspecified.
+func (p Period) Relation(other Period) Relation {
+	pStart, pEnd := p.bounds()
+	oStart, oEnd := other.bounds()
+
+	switch c := pStart.Compare(oStart); {
+	case c < 0:
+		switch e := pEnd.Compare(oStart); {
+		case e < 0:
+			return RelationPrecedes
+		case e == 0:
+			return RelationMeets
+		}
+
+		switch pEnd.Compare(oEnd) {
+		case -1:
+			return RelationOverlaps
+		case 0:
+			return RelationFinishedBy
+		default:
+			return RelationContains
+		}
+
+	case c == 0:
+		switch pEnd.Compare(oEnd) {
+		case -1:
+			return RelationStarts
+		case 0:
+			return RelationEquals
+		default:
+			return RelationStartedBy
+		}
+
+	default:
+		switch s := pStart.Compare(oEnd); {
+		case s > 0:
+			return RelationPrecededBy
+		case s == 0:
+			return RelationMetBy
+		}
+
+		switch pEnd.Compare(oEnd) {
+		case -1:
+			return RelationDuring
+		case 0:
+			return RelationFinishes
+		default:
+			return RelationOverlappedBy
+		}
+	}
+}
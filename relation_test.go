@@ -0,0 +1,57 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestPeriod_Relation(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return jan1.AddDate(0, 0, d-1) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: day(start), End: day(end)} }
+
+	tests := []struct {
+		a, b timefn.Period
+		want timefn.Relation
+	}{
+		{a: p(1, 2), b: p(3, 4), want: timefn.RelationPrecedes},
+		{a: p(1, 3), b: p(3, 4), want: timefn.RelationMeets},
+		{a: p(1, 3), b: p(2, 4), want: timefn.RelationOverlaps},
+		{a: p(1, 4), b: p(2, 4), want: timefn.RelationFinishedBy},
+		{a: p(1, 5), b: p(2, 4), want: timefn.RelationContains},
+		{a: p(1, 3), b: p(1, 4), want: timefn.RelationStarts},
+		{a: p(1, 4), b: p(1, 4), want: timefn.RelationEquals},
+		{a: p(1, 5), b: p(1, 4), want: timefn.RelationStartedBy},
+		{a: p(2, 3), b: p(1, 4), want: timefn.RelationDuring},
+		{a: p(2, 4), b: p(1, 4), want: timefn.RelationFinishes},
+		{a: p(2, 5), b: p(1, 4), want: timefn.RelationOverlappedBy},
+		{a: p(4, 5), b: p(1, 4), want: timefn.RelationMetBy},
+		{a: p(5, 6), b: p(1, 4), want: timefn.RelationPrecededBy},
+		{a: timefn.Period{Start: day(1)}, b: p(2, 3), want: timefn.RelationContains},
+		{a: timefn.Period{Start: day(1)}, b: timefn.Period{Start: day(2)}, want: timefn.RelationFinishedBy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want.String(), func(t *testing.T) {
+			if got := tt.a.Relation(tt.b); got != tt.want {
+				t.Errorf("%s.Relation(%s) = %s, want %s", tt.a, tt.b, got, tt.want)
+			}
+
+			if got := tt.b.Relation(tt.a); got != tt.want.Inverse() {
+				t.Errorf("%s.Relation(%s) = %s, want %s", tt.b, tt.a, got, tt.want.Inverse())
+			}
+		})
+	}
+}
+
+func TestRelation_Inverse(t *testing.T) {
+	if got := timefn.RelationEquals.Inverse(); got != timefn.RelationEquals {
+		t.Errorf("expected inverse of %s to be %s; got %s", timefn.RelationEquals, timefn.RelationEquals, got)
+	}
+
+	if got := timefn.RelationOverlaps.Inverse(); got != timefn.RelationOverlappedBy {
+		t.Errorf("expected inverse of %s to be %s; got %s", timefn.RelationOverlaps, timefn.RelationOverlappedBy, got)
+	}
+}
@@ -0,0 +1,109 @@
+package timefn
+
+import "math"
+
+// ValuedPeriod is a [Period] with a value attached to it, such as the power
+// consumption of a machine during the period. A slice of valued periods
+// describes a step function over time.
+type ValuedPeriod[V any] struct {
+	Period Period `json:"period"`
+	Value  V      `json:"value"`
+}
+
+// Aggregator decides how [Resample] combines the values that fall into a
+// bucket.
+type Aggregator int
+
+const (
+	// AggregateSum treats each value as an amount that is spread evenly over
+	// its period, such as the energy consumed during the period, and sums up
+	// the shares of the amounts that fall into a bucket. A bucket that
+	// overlaps half of a period receives half of its value.
+	AggregateSum Aggregator = iota + 1
+
+	// AggregateMean computes the mean of the values within a bucket, weighted
+	// by the time each value covers within the bucket. Parts of the bucket
+	// that are not covered by any period are ignored.
+	AggregateMean
+
+	// AggregateMax computes the maximum of the values of the periods that
+	// overlap a bucket.
+	AggregateMax
+)
+
+var aggregatorNames = [...]string{
+	AggregateSum:  "sum",
+	AggregateMean: "mean",
+	AggregateMax:  "max",
+}
+
+// String returns the name of the aggregator, e.g. "mean".
+func (a Aggregator) String() string {
+	if a < AggregateSum || a > AggregateMax {
+		return "<unknown aggregator>"
+	}
+	return aggregatorNames[a]
+}
+
+// Resample aligns the step function described by values onto the given
+// buckets and returns one aggregated value per bucket, in the order of the
+// buckets. The buckets may be any periods, e.g. the days of a month or the
+// working hours of a week, and may overlap. Buckets that no value overlaps are
+// 0 for [AggregateSum] and NaN for the other aggregators. Values whose period
+// is not valid, such as open or empty periods, are ignored. Resample returns
+// nil if the aggregator is unknown.
+func Resample(values []ValuedPeriod[float64], buckets []Period, agg Aggregator) []float64 {
+	if agg < AggregateSum || agg > AggregateMax {
+		return nil
+	}
+
+	out := make([]float64, len(buckets))
+	for i, bucket := range buckets {
+		out[i] = resampleBucket(values, bucket, agg)
+	}
+
+	return out
+}
+
+// resampleBucket aggregates the values that overlap bucket.
+func resampleBucket(values []ValuedPeriod[float64], bucket Period, agg Aggregator) float64 {
+	var (
+		sum, weight float64
+		found       bool
+	)
+
+	result := math.Inf(-1)
+	for _, v := range values {
+		if v.Period.Validate() != nil {
+			continue
+		}
+
+		overlap, ok := v.Period.intersection(bucket)
+		if !ok {
+			continue
+		}
+		found = true
+
+		share := float64(overlap.Duration())
+		switch agg {
+		case AggregateSum:
+			sum += v.Value * share / float64(v.Period.Duration())
+		case AggregateMean:
+			sum += v.Value * share
+			weight += share
+		case AggregateMax:
+			result = math.Max(result, v.Value)
+		}
+	}
+
+	switch {
+	case agg == AggregateSum:
+		return sum
+	case !found:
+		return math.NaN()
+	case agg == AggregateMean:
+		return sum / weight
+	default:
+		return result
+	}
+}
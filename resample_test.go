@@ -0,0 +1,54 @@
+package timefn_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestResample(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: at(start), End: at(end)} }
+
+	values := []timefn.ValuedPeriod[float64]{
+		{Period: p(0, 2), Value: 10},
+		{Period: p(2, 3), Value: 40},
+		{Period: p(5, 6), Value: 6},
+		{Period: timefn.Period{Start: at(0)}, Value: 1000},
+	}
+
+	buckets := []timefn.Period{p(0, 1), p(1, 3), p(0, 4), p(3, 5), p(4, 6)}
+
+	tests := []struct {
+		agg  timefn.Aggregator
+		want []float64
+	}{
+		{agg: timefn.AggregateSum, want: []float64{5, 45, 50, 0, 6}},
+		{agg: timefn.AggregateMean, want: []float64{10, 25, 20, math.NaN(), 6}},
+		{agg: timefn.AggregateMax, want: []float64{10, 40, 40, math.NaN(), 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.agg.String(), func(t *testing.T) {
+			got := timefn.Resample(values, buckets, tt.agg)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Resample() = %v; want %v", got, tt.want)
+			}
+			for i := range got {
+				if math.IsNaN(tt.want[i]) && math.IsNaN(got[i]) {
+					continue
+				}
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("Resample() = %v; want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+
+	if got := timefn.Resample(values, buckets, 0); got != nil {
+		t.Errorf("Resample() with unknown aggregator = %v; want nil", got)
+	}
+}
@@ -0,0 +1,80 @@
+package timefn
+
+// RollConvention is a business day convention that decides how a date that
+// falls on a non-business day, such as a coupon or settlement date, is moved
+// to a business day. See [RollDate].
+type RollConvention int
+
+const (
+	// Following rolls a date forward to the next business day.
+	Following RollConvention = iota + 1
+
+	// ModifiedFollowing rolls a date forward to the next business day, unless
+	// that day falls into the next month, in which case the date is rolled
+	// back to the previous business day instead.
+	ModifiedFollowing
+
+	// Preceding rolls a date back to the previous business day.
+	Preceding
+
+	// ModifiedPreceding rolls a date back to the previous business day, unless
+	// that day falls into the previous month, in which case the date is rolled
+	// forward to the next business day instead.
+	ModifiedPreceding
+)
+
+var rollConventionNames = [...]string{
+	Following:         "following",
+	ModifiedFollowing: "modified following",
+	Preceding:         "preceding",
+	ModifiedPreceding: "modified preceding",
+}
+
+// String returns the name of the convention, e.g. "modified following".
+func (c RollConvention) String() string {
+	if c < Following || c > ModifiedPreceding {
+		return "<unknown roll convention>"
+	}
+	return rollConventionNames[c]
+}
+
+// RollDate moves d to a business day of cal according to the given convention.
+// Dates that already are business days are returned unchanged, as are all
+// dates if cal is nil, the convention is unknown, or cal has no business days
+// within five years of d.
+func RollDate(d Date, convention RollConvention, cal *BusinessCalendar) Date {
+	if cal == nil || cal.IsBusinessDay(d.utc()) {
+		return d
+	}
+
+	switch convention {
+	case Following:
+		return rollBy(d, 1, cal)
+	case ModifiedFollowing:
+		if next := rollBy(d, 1, cal); next.Month == d.Month {
+			return next
+		}
+		return rollBy(d, -1, cal)
+	case Preceding:
+		return rollBy(d, -1, cal)
+	case ModifiedPreceding:
+		if prev := rollBy(d, -1, cal); prev.Month == d.Month {
+			return prev
+		}
+		return rollBy(d, 1, cal)
+	default:
+		return d
+	}
+}
+
+// rollBy returns the nearest business day of cal after d if step is positive,
+// or before d if step is negative. It returns d if there is none within five
+// years.
+func rollBy(d Date, step int, cal *BusinessCalendar) Date {
+	for i := 1; i <= maxBusinessDaySearch; i++ {
+		if next := d.AddDays(step * i); cal.IsBusinessDay(next.utc()) {
+			return next
+		}
+	}
+	return d
+}
@@ -0,0 +1,57 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestRollDate(t *testing.T) {
+	cal := timefn.NewBusinessCalendar(timefn.WithHolidays(
+		time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC),
+	))
+
+	date := func(s string) timefn.Date {
+		d, err := timefn.ParseDate(s)
+		if err != nil {
+			t.Fatalf("parse date: %v", err)
+		}
+		return d
+	}
+
+	tests := []struct {
+		name       string
+		date       string
+		convention timefn.RollConvention
+		want       string
+	}{
+		{name: "business day", date: "2023-03-15", convention: timefn.Following, want: "2023-03-15"},
+		{name: "following", date: "2023-03-18", convention: timefn.Following, want: "2023-03-20"},
+		{name: "preceding", date: "2023-03-18", convention: timefn.Preceding, want: "2023-03-17"},
+		{name: "following across month end", date: "2023-04-30", convention: timefn.Following, want: "2023-05-01"},
+		{name: "modified following within month", date: "2023-03-18", convention: timefn.ModifiedFollowing, want: "2023-03-20"},
+		{name: "modified following across month end", date: "2023-04-29", convention: timefn.ModifiedFollowing, want: "2023-04-28"},
+		{name: "modified following before holiday", date: "2023-03-31", convention: timefn.ModifiedFollowing, want: "2023-03-30"},
+		{name: "modified preceding within month", date: "2023-03-19", convention: timefn.ModifiedPreceding, want: "2023-03-17"},
+		{name: "modified preceding across month start", date: "2023-04-01", convention: timefn.ModifiedPreceding, want: "2023-04-03"},
+		{name: "unknown convention", date: "2023-03-18", want: "2023-03-18"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.RollDate(date(tt.date), tt.convention, cal); got.String() != tt.want {
+				t.Errorf("RollDate(%s, %s) = %s; want %s", tt.date, tt.convention, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollConvention_String(t *testing.T) {
+	if got := timefn.ModifiedFollowing.String(); got != "modified following" {
+		t.Errorf("String() = %q; want %q", got, "modified following")
+	}
+	if got := timefn.RollConvention(0).String(); got != "<unknown roll convention>" {
+		t.Errorf("String() = %q; want %q", got, "<unknown roll convention>")
+	}
+}
@@ -0,0 +1,38 @@
+package timefn
+
+import "time"
+
+// RotationPolicy describes a calendar-based rotation schedule, such as the
+// rotation of log files at local midnight. A new rotation starts at the start
+// of every Unit, evaluated in Location, or in UTC if Location is nil.
+type RotationPolicy struct {
+	Unit     Unit
+	Location *time.Location
+}
+
+func (p RotationPolicy) location() *time.Location {
+	if p.Location == nil {
+		return time.UTC
+	}
+	return p.Location
+}
+
+// NextRotation returns the time of the first rotation after now. Rotations
+// happen at the start of each unit on the wall clock of the policy's location,
+// so daily rotations happen at local midnight regardless of DST transitions.
+// If midnight does not exist on a day, the rotation happens at the first
+// instant of that day. NextRotation returns the zero time if the unit of the
+// policy is unknown, which includes the zero [RotationPolicy].
+func NextRotation(now time.Time, policy RotationPolicy) time.Time {
+	return RotationPeriod(now, policy).End
+}
+
+// RotationPeriod returns the period that is covered by the rotation that is
+// active at t, i.e. the time span covered by a single rotated file. It returns
+// the empty period if the unit of the policy is unknown.
+func RotationPeriod(t time.Time, policy RotationPolicy) Period {
+	if policy.Unit.approx() == 0 {
+		return Period{}
+	}
+	return policy.Unit.Period(t.In(policy.location()))
+}
@@ -0,0 +1,99 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestNextRotation(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	// Havana switches to DST at midnight, so March 12, 2023 starts at 01:00.
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		now    time.Time
+		policy timefn.RotationPolicy
+		want   time.Time
+	}{
+		{
+			name:   "daily in UTC",
+			now:    time.Date(2023, time.March, 25, 23, 59, 0, 0, time.UTC),
+			policy: timefn.RotationPolicy{Unit: timefn.UnitDay},
+			want:   time.Date(2023, time.March, 26, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "daily at local midnight",
+			now:    time.Date(2023, time.March, 25, 23, 30, 0, 0, time.UTC),
+			policy: timefn.RotationPolicy{Unit: timefn.UnitDay, Location: berlin},
+			want:   time.Date(2023, time.March, 27, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:   "daily on a day without midnight",
+			now:    time.Date(2023, time.March, 11, 12, 0, 0, 0, havana),
+			policy: timefn.RotationPolicy{Unit: timefn.UnitDay, Location: havana},
+			want:   time.Date(2023, time.March, 12, 1, 0, 0, 0, havana),
+		},
+		{
+			name:   "daily after a day without midnight",
+			now:    time.Date(2023, time.March, 12, 1, 0, 0, 0, havana),
+			policy: timefn.RotationPolicy{Unit: timefn.UnitDay, Location: havana},
+			want:   time.Date(2023, time.March, 13, 0, 0, 0, 0, havana),
+		},
+		{
+			name:   "weekly",
+			now:    time.Date(2023, time.March, 22, 12, 0, 0, 0, berlin),
+			policy: timefn.RotationPolicy{Unit: timefn.UnitWeek, Location: berlin},
+			want:   time.Date(2023, time.March, 27, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:   "monthly",
+			now:    time.Date(2023, time.January, 31, 12, 0, 0, 0, berlin),
+			policy: timefn.RotationPolicy{Unit: timefn.UnitMonth, Location: berlin},
+			want:   time.Date(2023, time.February, 1, 0, 0, 0, 0, berlin),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.NextRotation(tt.now, tt.policy); !got.Equal(tt.want) {
+				t.Errorf("NextRotation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRotationPeriod(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	p := timefn.RotationPeriod(time.Date(2023, time.October, 29, 12, 0, 0, 0, berlin), timefn.RotationPolicy{Unit: timefn.UnitDay, Location: berlin})
+
+	if d := p.Duration(); d != 25*time.Hour {
+		t.Errorf("expected the day of the DST transition to last 25h; got %v (%v)", d, p)
+	}
+}
+
+func TestNextRotation_unknownUnit(t *testing.T) {
+	now := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, policy := range []timefn.RotationPolicy{{}, {Unit: timefn.UnitYear + 1}} {
+		if got := timefn.NextRotation(now, policy); !got.IsZero() {
+			t.Errorf("NextRotation() with unit %d = %v, want the zero time", int(policy.Unit), got)
+		}
+		if got := timefn.RotationPeriod(now, policy); !got.IsZero() {
+			t.Errorf("RotationPeriod() with unit %d = %v, want the empty period", int(policy.Unit), got)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package timefn
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var rruleFrequencies = map[string]Frequency{
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+	"YEARLY":  Yearly,
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseRRule parses an iCalendar recurrence rule as defined by RFC 5545, such
+// as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10", into a [Recurrence] whose
+// first occurrence is the given anchor. An optional "RRULE:" prefix is
+// ignored.
+//
+// The rule parts FREQ, INTERVAL, COUNT, UNTIL, BYDAY and BYMONTHDAY are
+// supported, as well as WKST=MO. ParseRRule returns an error for all other
+// rule parts and for the frequencies SECONDLY, MINUTELY and HOURLY. An UNTIL
+// without a time includes the whole day in the location of the anchor, and an
+// UNTIL without a "Z" suffix is interpreted in the location of the anchor.
+func ParseRRule(rule string, anchor Period) (Recurrence, error) {
+	r := Recurrence{Anchor: anchor}
+
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" {
+		return Recurrence{}, fmt.Errorf("empty rule")
+	}
+
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Recurrence{}, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			freq, ok := rruleFrequencies[strings.ToUpper(value)]
+			if !ok {
+				return Recurrence{}, fmt.Errorf("unsupported frequency %q", value)
+			}
+			r.Frequency = freq
+		case "INTERVAL":
+			r.Interval, err = parsePositiveInt(value)
+		case "COUNT":
+			r.Count, err = parsePositiveInt(value)
+		case "UNTIL":
+			r.Until, err = parseRRuleUntil(value, anchor.Start.Location())
+		case "BYDAY":
+			r.ByDay, err = parseRRuleByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseRRuleByMonthDay(value)
+		case "WKST":
+			if !strings.EqualFold(value, "MO") {
+				err = fmt.Errorf("only weeks starting on MO are supported")
+			}
+		default:
+			return Recurrence{}, fmt.Errorf("unsupported rule part %q", key)
+		}
+
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("%s: %w", strings.ToUpper(key), err)
+		}
+	}
+
+	if r.Frequency == 0 {
+		return Recurrence{}, fmt.Errorf("missing FREQ")
+	}
+
+	if r.Count > 0 && !r.Until.IsZero() {
+		return Recurrence{}, fmt.Errorf("COUNT and UNTIL must not be used together")
+	}
+
+	if err := r.Validate(); err != nil {
+		return Recurrence{}, err
+	}
+
+	return r, nil
+}
+
+// ExpandRRule parses the recurrence rule using [ParseRRule] and returns the
+// occurrences that overlap with the given bounds. Each occurrence starts at
+// the wall-clock time of start and lasts for the given duration.
+func ExpandRRule(rule string, start time.Time, d time.Duration, bounds Period) ([]Period, error) {
+	r, err := ParseRRule(rule, Period{Start: start, End: start.Add(d)})
+	if err != nil {
+		return nil, err
+	}
+	return r.Occurrences(bounds), nil
+}
+
+func parsePositiveInt(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < 1 {
+		return 0, fmt.Errorf("must be positive; is %d", n)
+	}
+	return n, nil
+}
+
+func parseRRuleUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date or date-time %q", value)
+	}
+
+	return EndOfDay(t), nil
+}
+
+func parseRRuleByDay(value string) ([]NthWeekday, error) {
+	var out []NthWeekday
+	for _, item := range strings.Split(value, ",") {
+		item = strings.ToUpper(strings.TrimSpace(item))
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+
+		weekday, ok := rruleWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+
+		var n int
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			if n, err = strconv.Atoi(prefix); err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid weekday %q", item)
+			}
+		}
+
+		out = append(out, NthWeekday{Weekday: weekday, N: n})
+	}
+	return out, nil
+}
+
+func parseRRuleByMonthDay(value string) ([]int, error) {
+	var out []int
+	for _, item := range strings.Split(value, ",") {
+		d, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil {
+			return nil, fmt.Errorf("invalid day of month %q", item)
+		}
+		out = append(out, d)
+	}
+	return out, nil
+}
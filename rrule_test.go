@@ -0,0 +1,161 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestExpandRRule(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 9, 0, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		name   string
+		rule   string
+		start  time.Time
+		bounds timefn.Period
+		want   []time.Time
+	}{
+		{
+			name:   "weekly on multiple days",
+			rule:   "FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=5",
+			start:  day(2023, time.March, 22),
+			bounds: timefn.Period{Start: day(2023, time.January, 1)},
+			want: []time.Time{
+				day(2023, time.March, 22),
+				day(2023, time.March, 24),
+				day(2023, time.March, 27),
+				day(2023, time.March, 29),
+				day(2023, time.March, 31),
+			},
+		},
+		{
+			name:   "every other week",
+			rule:   "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU",
+			start:  day(2023, time.January, 3),
+			bounds: timefn.Period{Start: day(2023, time.January, 1), End: day(2023, time.February, 1)},
+			want: []time.Time{
+				day(2023, time.January, 3),
+				day(2023, time.January, 17),
+				day(2023, time.January, 31),
+			},
+		},
+		{
+			name:   "last Friday of the month",
+			rule:   "FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20230430",
+			start:  day(2023, time.January, 27),
+			bounds: timefn.Period{Start: day(2023, time.January, 1)},
+			want: []time.Time{
+				day(2023, time.January, 27),
+				day(2023, time.February, 24),
+				day(2023, time.March, 31),
+				day(2023, time.April, 28),
+			},
+		},
+		{
+			name:   "first and last day of the month",
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=4",
+			start:  day(2023, time.January, 1),
+			bounds: timefn.Period{Start: day(2023, time.January, 1)},
+			want: []time.Time{
+				day(2023, time.January, 1),
+				day(2023, time.January, 31),
+				day(2023, time.February, 1),
+				day(2023, time.February, 28),
+			},
+		},
+		{
+			name:   "Friday the 13th",
+			rule:   "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=3",
+			start:  day(2023, time.January, 13),
+			bounds: timefn.Period{Start: day(2023, time.January, 1)},
+			want: []time.Time{
+				day(2023, time.January, 13),
+				day(2023, time.October, 13),
+				day(2024, time.September, 13),
+			},
+		},
+		{
+			name:   "weekdays",
+			rule:   "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			start:  day(2023, time.March, 24),
+			bounds: timefn.Period{Start: day(2023, time.March, 24), End: day(2023, time.March, 29)},
+			want: []time.Time{
+				day(2023, time.March, 24),
+				day(2023, time.March, 27),
+				day(2023, time.March, 28),
+			},
+		},
+		{
+			name:   "yearly on the 20th Monday",
+			rule:   "FREQ=YEARLY;BYDAY=20MO;COUNT=2",
+			start:  day(2023, time.May, 15),
+			bounds: timefn.Period{Start: day(2023, time.January, 1)},
+			want: []time.Time{
+				day(2023, time.May, 15),
+				day(2024, time.May, 13),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timefn.ExpandRRule(tt.rule, tt.start, 90*time.Minute, tt.bounds)
+			if err != nil {
+				t.Fatalf("ExpandRRule() failed: %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("ExpandRRule() = %v, want starts %v", got, tt.want)
+			}
+
+			for i, p := range got {
+				if !p.Start.Equal(tt.want[i]) {
+					t.Errorf("occurrence %d starts at %v, want %v", i, p.Start, tt.want[i])
+				}
+				if p.End.Sub(p.Start) != 90*time.Minute {
+					t.Errorf("occurrence %d lasts %v, want %v", i, p.End.Sub(p.Start), 90*time.Minute)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRRule_invalid(t *testing.T) {
+	anchor := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	rules := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=3;UNTIL=20230201",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYSETPOS=1",
+		"FREQ=MONTHLY;WKST=SU",
+		"FREQ",
+	}
+
+	for _, rule := range rules {
+		t.Run(rule, func(t *testing.T) {
+			if _, err := timefn.ParseRRule(rule, anchor); err == nil {
+				t.Errorf("expected ParseRRule(%q) to fail", rule)
+			}
+		})
+	}
+}
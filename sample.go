@@ -0,0 +1,50 @@
+package timefn
+
+import "time"
+
+// maxSamples limits the number of instants that [SamplePeriods] returns.
+const maxSamples = 1_000_000
+
+// SamplePeriods returns deterministic sample instants within p, such as every
+// Monday at 03:00 for audit sampling or probe scheduling. The n-th sample is
+// the start of p shifted by n times every, plus offset:
+//
+//	// Every Monday at 03:00, given that p starts on a Monday at midnight.
+//	timefn.SamplePeriods(p, timefn.CalendarDuration{Days: 7}, 3*time.Hour)
+//
+// Each multiple of every is added to the start of p rather than to the
+// previous sample, and months are clamped to their last day as in
+// [CalendarDuration.AddTo], so month-end dates do not drift: monthly samples
+// starting on January 31 fall on the last day of each month. The offset is
+// applied to the wall clock in the location of p.Start, so 03:00 stays 03:00
+// on days with a daylight saving time transition. Only samples within
+// [p.Start, p.End) are returned. SamplePeriods returns nil if p is not valid,
+// has an open boundary, or if every does not move forward in time.
+func SamplePeriods(p Period, every CalendarDuration, offset time.Duration) []time.Time {
+	if p.Validate() != nil {
+		return nil
+	}
+
+	if !every.AddTo(p.Start).After(p.Start) {
+		return nil
+	}
+
+	loc := p.Start.Location()
+
+	var out []time.Time
+	for n := 0; n < maxSamples; n++ {
+		base := every.times(n).AddTo(p.Start)
+		if !base.Add(offset).Before(p.End) && !base.Before(p.End) {
+			break
+		}
+
+		y, m, d := base.Date()
+		wall := time.Date(y, m, d, base.Hour(), base.Minute(), base.Second(), base.Nanosecond(), time.UTC).Add(offset)
+		sample, _ := resolveWallClock(wall, loc, wallClockConfig{})
+		if p.Contains(sample) {
+			out = append(out, sample)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,119 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSamplePeriods(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		p      timefn.Period
+		every  timefn.CalendarDuration
+		offset time.Duration
+		want   []time.Time
+	}{
+		{
+			name: "every monday at 03:00",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 13, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.April, 3, 0, 0, 0, 0, time.UTC),
+			},
+			every:  timefn.CalendarDuration{Days: 7},
+			offset: 3 * time.Hour,
+			want: []time.Time{
+				time.Date(2023, time.March, 13, 3, 0, 0, 0, time.UTC),
+				time.Date(2023, time.March, 20, 3, 0, 0, 0, time.UTC),
+				time.Date(2023, time.March, 27, 3, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "wall clock across dst",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 25, 0, 0, 0, 0, berlin),
+				End:   time.Date(2023, time.March, 28, 0, 0, 0, 0, berlin),
+			},
+			every:  timefn.CalendarDuration{Days: 1},
+			offset: 3 * time.Hour,
+			want: []time.Time{
+				time.Date(2023, time.March, 25, 3, 0, 0, 0, berlin),
+				time.Date(2023, time.March, 26, 3, 0, 0, 0, berlin),
+				time.Date(2023, time.March, 27, 3, 0, 0, 0, berlin),
+			},
+		},
+		{
+			name: "monthly without drift",
+			p: timefn.Period{
+				Start: time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC),
+			},
+			every: timefn.CalendarDuration{Months: 1},
+			want: []time.Time{
+				time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, time.April, 30, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "month end in leap year",
+			p: timefn.Period{
+				Start: time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC),
+				End:   time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+			},
+			every: timefn.CalendarDuration{Months: 1},
+			want: []time.Time{
+				time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, time.April, 30, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, time.May, 31, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "negative offset",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 3, 0, 0, 0, 0, time.UTC),
+			},
+			every:  timefn.CalendarDuration{Days: 1},
+			offset: -time.Hour,
+			want: []time.Time{
+				time.Date(2023, time.March, 1, 23, 0, 0, 0, time.UTC),
+				time.Date(2023, time.March, 2, 23, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "zero step",
+			p: timefn.Period{
+				Start: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.March, 3, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "open end",
+			p:     timefn.Period{Start: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			every: timefn.CalendarDuration{Days: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timefn.SamplePeriods(tt.p, tt.every, tt.offset)
+			assert.Equal(t, len(tt.want), len(got))
+			for i := range got {
+				if i < len(tt.want) && !got[i].Equal(tt.want[i]) {
+					t.Errorf("sample %d: got %v; want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
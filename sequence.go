@@ -0,0 +1,60 @@
+package timefn
+
+// IsSorted returns whether the periods are sorted by [ComparePeriods].
+func IsSorted(periods []Period) bool {
+	return FirstUnsorted(periods) < 0
+}
+
+// IsNonOverlapping returns whether each period starts at or after the end of
+// the period before it, i.e. whether the periods are sorted and do not
+// overlap. Adjacent periods do not overlap.
+func IsNonOverlapping(periods []Period) bool {
+	return FirstOverlap(periods) < 0
+}
+
+// IsContiguous returns whether each period starts exactly at the end of the
+// period before it, so that the periods cover a single span of time without
+// gaps or overlaps.
+func IsContiguous(periods []Period) bool {
+	return FirstDiscontinuity(periods) < 0
+}
+
+// FirstUnsorted returns the index of the first period that sorts before the
+// period before it, as defined by [ComparePeriods], or -1 if the periods are
+// sorted.
+func FirstUnsorted(periods []Period) int {
+	for i := 1; i < len(periods); i++ {
+		if ComparePeriods(periods[i-1], periods[i]) > 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// FirstOverlap returns the index of the first period that starts before the
+// end of the period before it, or -1 if the periods do not overlap. See
+// [IsNonOverlapping].
+func FirstOverlap(periods []Period) int {
+	for i := 1; i < len(periods); i++ {
+		_, prevEnd := periods[i-1].bounds()
+		start, _ := periods[i].bounds()
+		if start.Before(prevEnd) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FirstDiscontinuity returns the index of the first period that does not start
+// exactly at the end of the period before it, or -1 if the periods are
+// contiguous. See [IsContiguous].
+func FirstDiscontinuity(periods []Period) int {
+	for i := 1; i < len(periods); i++ {
+		_, prevEnd := periods[i-1].bounds()
+		start, _ := periods[i].bounds()
+		if !start.Equal(prevEnd) {
+			return i
+		}
+	}
+	return -1
+}
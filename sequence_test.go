@@ -0,0 +1,102 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestSequencePredicates(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC) }
+	p := func(start, end int) timefn.Period { return timefn.Period{Start: at(start), End: at(end)} }
+
+	tests := []struct {
+		name              string
+		periods           []timefn.Period
+		wantUnsorted      int
+		wantOverlap       int
+		wantDiscontinuity int
+	}{
+		{
+			name:              "empty",
+			wantUnsorted:      -1,
+			wantOverlap:       -1,
+			wantDiscontinuity: -1,
+		},
+		{
+			name:              "single",
+			periods:           []timefn.Period{p(1, 2)},
+			wantUnsorted:      -1,
+			wantOverlap:       -1,
+			wantDiscontinuity: -1,
+		},
+		{
+			name:              "contiguous",
+			periods:           []timefn.Period{p(1, 2), p(2, 4), p(4, 5)},
+			wantUnsorted:      -1,
+			wantOverlap:       -1,
+			wantDiscontinuity: -1,
+		},
+		{
+			name:              "gap",
+			periods:           []timefn.Period{p(1, 2), p(2, 3), p(4, 5)},
+			wantUnsorted:      -1,
+			wantOverlap:       -1,
+			wantDiscontinuity: 2,
+		},
+		{
+			name:              "overlap",
+			periods:           []timefn.Period{p(1, 2), p(2, 4), p(3, 5)},
+			wantUnsorted:      -1,
+			wantOverlap:       2,
+			wantDiscontinuity: 2,
+		},
+		{
+			name:              "unsorted",
+			periods:           []timefn.Period{p(3, 4), p(1, 2)},
+			wantUnsorted:      1,
+			wantOverlap:       1,
+			wantDiscontinuity: 1,
+		},
+		{
+			name:              "open end before",
+			periods:           []timefn.Period{{Start: at(1)}, p(2, 3)},
+			wantUnsorted:      -1,
+			wantOverlap:       1,
+			wantDiscontinuity: 1,
+		},
+		{
+			name:              "open boundaries",
+			periods:           []timefn.Period{{End: at(1)}, p(1, 2), {Start: at(2)}},
+			wantUnsorted:      -1,
+			wantOverlap:       -1,
+			wantDiscontinuity: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.FirstUnsorted(tt.periods); got != tt.wantUnsorted {
+				t.Errorf("FirstUnsorted() = %d; want %d", got, tt.wantUnsorted)
+			}
+			if got := timefn.IsSorted(tt.periods); got != (tt.wantUnsorted < 0) {
+				t.Errorf("IsSorted() = %v", got)
+			}
+
+			if got := timefn.FirstOverlap(tt.periods); got != tt.wantOverlap {
+				t.Errorf("FirstOverlap() = %d; want %d", got, tt.wantOverlap)
+			}
+			if got := timefn.IsNonOverlapping(tt.periods); got != (tt.wantOverlap < 0) {
+				t.Errorf("IsNonOverlapping() = %v", got)
+			}
+
+			if got := timefn.FirstDiscontinuity(tt.periods); got != tt.wantDiscontinuity {
+				t.Errorf("FirstDiscontinuity() = %d; want %d", got, tt.wantDiscontinuity)
+			}
+			if got := timefn.IsContiguous(tt.periods); got != (tt.wantDiscontinuity < 0) {
+				t.Errorf("IsContiguous() = %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Package solar computes sunrise and sunset times and returns daylight as
+// [timefn.Period]s, so that work windows can be intersected with daylight
+// using the period functions of timefn.
+//
+// The calculations follow the sunrise equation as used by the NOAA solar
+// calculator and are accurate to about a minute for latitudes between the
+// polar circles. Latitudes are given in degrees north and longitudes in
+// degrees east.
+package solar
+
+import (
+	"math"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+// Elevations of the sun's center that define common events, in degrees above
+// the horizon.
+const (
+	// Sunrise is the elevation at which the upper limb of the sun touches the
+	// horizon, accounting for atmospheric refraction.
+	Sunrise = -0.833
+
+	// CivilTwilight is the elevation that marks the start of civil dawn and the
+	// end of civil dusk.
+	CivilTwilight = -6.0
+
+	// NauticalTwilight is the elevation that marks the start of nautical dawn
+	// and the end of nautical dusk.
+	NauticalTwilight = -12.0
+
+	// AstronomicalTwilight is the elevation that marks the start of
+	// astronomical dawn and the end of astronomical dusk.
+	AstronomicalTwilight = -18.0
+)
+
+const (
+	julianUnixEpoch = 2440587.5
+	julian2000      = 2451545.0
+	secondsPerDay   = 86400
+	obliquity       = 23.4397
+)
+
+// Day describes the course of the sun on a single day at a specific location.
+type Day struct {
+	// Rise is the time at which the sun rises above the elevation the Day was
+	// computed for.
+	Rise time.Time
+
+	// Set is the time at which the sun sets below the elevation the Day was
+	// computed for.
+	Set time.Time
+
+	// Noon is the time at which the sun reaches its highest point.
+	Noon time.Time
+
+	// AlwaysAbove is true if the sun stays above the elevation for the whole
+	// day, e.g. during polar day. Rise and Set are zero in that case.
+	AlwaysAbove bool
+
+	// AlwaysBelow is true if the sun stays below the elevation for the whole
+	// day, e.g. during polar night. Rise and Set are zero in that case.
+	AlwaysBelow bool
+}
+
+// Compute returns the course of the sun on the calendar day of date, as seen
+// in the location of date, for the given coordinates. Rise and Set are the
+// times at which the center of the sun crosses the given elevation in degrees,
+// such as [Sunrise] or [CivilTwilight]. All returned times are in the location
+// of date.
+func Compute(date time.Time, lat, lon, elevation float64) Day {
+	loc := date.Location()
+	y, m, d := date.Date()
+	noonUTC := time.Date(y, m, d, 12, 0, 0, 0, time.UTC)
+
+	n := math.Round(toJulian(noonUTC) - julian2000 + 0.0008)
+	meanSolarNoon := n - lon/360
+
+	anomaly := normalizeDegrees(357.5291 + 0.98560028*meanSolarNoon)
+	center := 1.9148*sin(anomaly) + 0.0200*sin(2*anomaly) + 0.0003*sin(3*anomaly)
+	eclipticLon := normalizeDegrees(anomaly + center + 180 + 102.9372)
+
+	transit := julian2000 + meanSolarNoon + 0.0053*sin(anomaly) - 0.0069*sin(2*eclipticLon)
+	declination := math.Asin(sin(eclipticLon) * sin(obliquity))
+
+	out := Day{Noon: fromJulian(transit).In(loc)}
+
+	cosHourAngle := (sin(elevation) - sin(lat)*math.Sin(declination)) / (cos(lat) * math.Cos(declination))
+	switch {
+	case cosHourAngle < -1:
+		out.AlwaysAbove = true
+	case cosHourAngle > 1:
+		out.AlwaysBelow = true
+	default:
+		hourAngle := math.Acos(cosHourAngle) * 180 / math.Pi
+		out.Rise = fromJulian(transit - hourAngle/360).In(loc)
+		out.Set = fromJulian(transit + hourAngle/360).In(loc)
+	}
+
+	return out
+}
+
+// SunriseSunset returns the sunrise and sunset on the calendar day of date, as
+// seen in the location of date, for the given coordinates. It returns false if
+// the sun does not rise or set on that day.
+func SunriseSunset(date time.Time, lat, lon float64) (sunrise, sunset time.Time, ok bool) {
+	day := Compute(date, lat, lon, Sunrise)
+	if day.AlwaysAbove || day.AlwaysBelow {
+		return time.Time{}, time.Time{}, false
+	}
+	return day.Rise, day.Set, true
+}
+
+// DaylightPeriod returns the period from sunrise to sunset on the calendar day
+// of date, as seen in the location of date, for the given coordinates. During
+// polar day, the whole day is returned. During polar night, DaylightPeriod
+// returns false.
+func DaylightPeriod(date time.Time, lat, lon float64) (timefn.Period, bool) {
+	return abovePeriod(date, lat, lon, Sunrise)
+}
+
+// DaylightPeriods returns the daylight periods of all days within the given
+// period, clipped to it. Days without daylight are skipped.
+func DaylightPeriods(within timefn.Period, lat, lon float64) []timefn.Period {
+	var out []timefn.Period
+	for _, date := range within.DatesStep(0) {
+		p, ok := DaylightPeriod(date, lat, lon)
+		if !ok {
+			continue
+		}
+		out = append(out, timefn.Intersect([]timefn.Period{p}, []timefn.Period{within})...)
+	}
+	return out
+}
+
+func abovePeriod(date time.Time, lat, lon, elevation float64) (timefn.Period, bool) {
+	day := Compute(date, lat, lon, elevation)
+
+	switch {
+	case day.AlwaysBelow:
+		return timefn.Period{}, false
+	case day.AlwaysAbove:
+		start := timefn.StartOfDay(date)
+		return timefn.Period{Start: start, End: start.AddDate(0, 0, 1)}, true
+	default:
+		return timefn.Period{Start: day.Rise, End: day.Set}, true
+	}
+}
+
+func toJulian(t time.Time) float64 {
+	return float64(t.UnixNano())/1e9/secondsPerDay + julianUnixEpoch
+}
+
+func fromJulian(j float64) time.Time {
+	return time.Unix(0, int64(math.Round((j-julianUnixEpoch)*secondsPerDay))*int64(time.Second))
+}
+
+func normalizeDegrees(d float64) float64 {
+	d = math.Mod(d, 360)
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
+
+func sin(deg float64) float64 {
+	return math.Sin(deg * math.Pi / 180)
+}
+
+func cos(deg float64) float64 {
+	return math.Cos(deg * math.Pi / 180)
+}
@@ -0,0 +1,103 @@
+package solar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/bounoable/timefn/solar"
+)
+
+func TestSunriseSunset(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		date        time.Time
+		lat, lon    float64
+		wantSunrise time.Time
+		wantSunset  time.Time
+	}{
+		{
+			name:        "Berlin, summer solstice",
+			date:        time.Date(2023, time.June, 21, 0, 0, 0, 0, berlin),
+			lat:         52.52,
+			lon:         13.405,
+			wantSunrise: time.Date(2023, time.June, 21, 4, 43, 0, 0, berlin),
+			wantSunset:  time.Date(2023, time.June, 21, 21, 33, 0, 0, berlin),
+		},
+		{
+			name:        "Berlin, winter solstice",
+			date:        time.Date(2023, time.December, 21, 0, 0, 0, 0, berlin),
+			lat:         52.52,
+			lon:         13.405,
+			wantSunrise: time.Date(2023, time.December, 21, 8, 15, 0, 0, berlin),
+			wantSunset:  time.Date(2023, time.December, 21, 15, 54, 0, 0, berlin),
+		},
+		{
+			name:        "Sydney",
+			date:        time.Date(2023, time.January, 1, 0, 0, 0, 0, time.FixedZone("AEDT", 11*3600)),
+			lat:         -33.8688,
+			lon:         151.2093,
+			wantSunrise: time.Date(2023, time.January, 1, 5, 47, 0, 0, time.FixedZone("AEDT", 11*3600)),
+			wantSunset:  time.Date(2023, time.January, 1, 20, 9, 0, 0, time.FixedZone("AEDT", 11*3600)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sunrise, sunset, ok := solar.SunriseSunset(tt.date, tt.lat, tt.lon)
+			if !ok {
+				t.Fatalf("expected sun to rise and set")
+			}
+
+			if d := sunrise.Sub(tt.wantSunrise); d < -2*time.Minute || d > 2*time.Minute {
+				t.Errorf("expected sunrise at about %v; got %v", tt.wantSunrise, sunrise)
+			}
+
+			if d := sunset.Sub(tt.wantSunset); d < -2*time.Minute || d > 2*time.Minute {
+				t.Errorf("expected sunset at about %v; got %v", tt.wantSunset, sunset)
+			}
+
+			if sunrise.Location() != tt.date.Location() {
+				t.Errorf("expected sunrise in %v; got %v", tt.date.Location(), sunrise.Location())
+			}
+		})
+	}
+}
+
+func TestDaylightPeriod_polar(t *testing.T) {
+	const lat, lon = 69.65, 18.96 // Tromsø
+
+	p, ok := solar.DaylightPeriod(time.Date(2023, time.June, 21, 0, 0, 0, 0, time.UTC), lat, lon)
+	if !ok {
+		t.Fatalf("expected daylight during polar day")
+	}
+
+	if p.Duration() != 24*time.Hour {
+		t.Errorf("expected daylight for the whole day; got %s", p)
+	}
+
+	if _, ok := solar.DaylightPeriod(time.Date(2023, time.December, 21, 0, 0, 0, 0, time.UTC), lat, lon); ok {
+		t.Errorf("expected no daylight during polar night")
+	}
+}
+
+func TestDaylightPeriods(t *testing.T) {
+	within := timefn.Period{
+		Start: time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	periods := solar.DaylightPeriods(within, 52.52, 13.405)
+	if len(periods) != 3 {
+		t.Fatalf("expected 3 daylight periods; got %d (%v)", len(periods), periods)
+	}
+
+	if !periods[0].Start.Equal(within.Start) {
+		t.Errorf("expected first daylight period to be clipped to %v; got %v", within.Start, periods[0].Start)
+	}
+}
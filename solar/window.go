@@ -0,0 +1,122 @@
+package solar
+
+import (
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+// Event is the moment at which the center of the sun crosses a given elevation,
+// either while rising in the morning or while setting in the evening.
+type Event struct {
+	// Elevation is the elevation of the sun's center in degrees above the
+	// horizon, such as [Sunrise] or [CivilTwilight].
+	Elevation float64
+
+	// Setting is true for evening events and false for morning events.
+	Setting bool
+}
+
+// Rise returns the morning [Event] at which the sun rises above the given
+// elevation. Rise(Sunrise) is sunrise, Rise(CivilTwilight) is civil dawn.
+func Rise(elevation float64) Event {
+	return Event{Elevation: elevation}
+}
+
+// Set returns the evening [Event] at which the sun sets below the given
+// elevation. Set(Sunrise) is sunset, Set(CivilTwilight) is civil dusk.
+func Set(elevation float64) Event {
+	return Event{Elevation: elevation, Setting: true}
+}
+
+// On returns the time of the event on the calendar day of date, as seen in the
+// location of date, for the given coordinates. It returns false if the sun does
+// not cross the elevation of the event on that day.
+func (e Event) On(date time.Time, lat, lon float64) (time.Time, bool) {
+	day := Compute(date, lat, lon, e.Elevation)
+	if day.AlwaysAbove || day.AlwaysBelow {
+		return time.Time{}, false
+	}
+	if e.Setting {
+		return day.Set, true
+	}
+	return day.Rise, true
+}
+
+// Offset returns a [Mark] that lies the given duration after the event. Use a
+// negative duration for marks before the event.
+func (e Event) Offset(d time.Duration) Mark {
+	return Mark{Event: e, Offset: d}
+}
+
+// Mark is a point in time relative to a solar [Event], such as "30 minutes
+// before sunset".
+type Mark struct {
+	Event  Event
+	Offset time.Duration
+}
+
+// On returns the time of the mark on the calendar day of date, as seen in the
+// location of date, for the given coordinates. It returns false if the event
+// of the mark does not occur on that day.
+func (m Mark) On(date time.Time, lat, lon float64) (time.Time, bool) {
+	t, ok := m.Event.On(date, lat, lon)
+	if !ok {
+		return time.Time{}, false
+	}
+	return t.Add(m.Offset), true
+}
+
+// Window is a recurring daily window between two solar marks, such as "30
+// minutes before sunset to 1 hour after sunset" or "civil dusk to civil dawn".
+// If the End mark does not lie after the Start mark on the same day, the
+// window ends at the End mark of the following day.
+type Window struct {
+	Start Mark
+	End   Mark
+}
+
+// On returns the window that starts on the calendar day of date, as seen in
+// the location of date, for the given coordinates. It returns false if one of
+// the events of the window does not occur.
+func (w Window) On(date time.Time, lat, lon float64) (timefn.Period, bool) {
+	start, ok := w.Start.On(date, lat, lon)
+	if !ok {
+		return timefn.Period{}, false
+	}
+
+	end, ok := w.End.On(date, lat, lon)
+	if !ok {
+		return timefn.Period{}, false
+	}
+
+	if !end.After(start) {
+		next := timefn.StartOfDay(date).AddDate(0, 0, 1)
+		if end, ok = w.End.On(next, lat, lon); !ok || !end.After(start) {
+			return timefn.Period{}, false
+		}
+	}
+
+	return timefn.Period{Start: start, End: end}, true
+}
+
+// Periods materializes the window for every day that touches the given period
+// and returns the resulting periods, clipped to it. Windows that start on the
+// day before the period and reach into it are included. Days on which the
+// window does not occur are skipped.
+func (w Window) Periods(within timefn.Period, lat, lon float64) []timefn.Period {
+	if within.Validate() != nil {
+		return nil
+	}
+
+	var out []timefn.Period
+	date := timefn.StartOfDay(within.Start).AddDate(0, 0, -1)
+	for !date.After(within.End) {
+		if p, ok := w.On(date, lat, lon); ok {
+			out = append(out, timefn.Intersect([]timefn.Period{p}, []timefn.Period{within})...)
+		}
+		date = timefn.StartOfDay(date.AddDate(0, 0, 1))
+	}
+
+	return out
+}
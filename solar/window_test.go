@@ -0,0 +1,112 @@
+package solar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/bounoable/timefn/solar"
+)
+
+const berlinLat, berlinLon = 52.52, 13.405
+
+func TestWindow_On(t *testing.T) {
+	date := time.Date(2023, time.June, 21, 0, 0, 0, 0, time.UTC)
+	sunrise, sunset, _ := solar.SunriseSunset(date, berlinLat, berlinLon)
+
+	tests := []struct {
+		name   string
+		window solar.Window
+		want   timefn.Period
+	}{
+		{
+			name: "around sunset",
+			window: solar.Window{
+				Start: solar.Set(solar.Sunrise).Offset(-30 * time.Minute),
+				End:   solar.Set(solar.Sunrise).Offset(time.Hour),
+			},
+			want: timefn.Period{Start: sunset.Add(-30 * time.Minute), End: sunset.Add(time.Hour)},
+		},
+		{
+			name: "sunrise to sunset",
+			window: solar.Window{
+				Start: solar.Rise(solar.Sunrise).Offset(0),
+				End:   solar.Set(solar.Sunrise).Offset(0),
+			},
+			want: timefn.Period{Start: sunrise, End: sunset},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.window.On(date, berlinLat, berlinLon)
+			if !ok {
+				t.Fatalf("expected window to occur")
+			}
+			if got != tt.want {
+				t.Errorf("On() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindow_On_overnight(t *testing.T) {
+	date := time.Date(2023, time.June, 21, 0, 0, 0, 0, time.UTC)
+	next := date.AddDate(0, 0, 1)
+
+	w := solar.Window{
+		Start: solar.Set(solar.CivilTwilight).Offset(0),
+		End:   solar.Rise(solar.CivilTwilight).Offset(0),
+	}
+
+	got, ok := w.On(date, berlinLat, berlinLon)
+	if !ok {
+		t.Fatalf("expected window to occur")
+	}
+
+	dusk, _ := solar.Set(solar.CivilTwilight).On(date, berlinLat, berlinLon)
+	dawn, _ := solar.Rise(solar.CivilTwilight).On(next, berlinLat, berlinLon)
+	if want := (timefn.Period{Start: dusk, End: dawn}); got != want {
+		t.Errorf("On() = %v, want %v", got, want)
+	}
+}
+
+func TestWindow_On_polar(t *testing.T) {
+	date := time.Date(2023, time.June, 21, 0, 0, 0, 0, time.UTC)
+	w := solar.Window{
+		Start: solar.Set(solar.Sunrise).Offset(0),
+		End:   solar.Set(solar.Sunrise).Offset(time.Hour),
+	}
+
+	if _, ok := w.On(date, 69.65, 18.96); ok {
+		t.Errorf("expected no window during polar day")
+	}
+}
+
+func TestWindow_Periods(t *testing.T) {
+	within := timefn.Period{
+		Start: time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+	}
+
+	w := solar.Window{
+		Start: solar.Set(solar.Sunrise).Offset(0),
+		End:   solar.Rise(solar.Sunrise).Offset(0),
+	}
+
+	periods := w.Periods(within, berlinLat, berlinLon)
+
+	// The night from May 31 reaches into the period, and the night of June 7
+	// is clipped at its end.
+	if len(periods) != 8 {
+		t.Fatalf("expected 8 periods; got %d (%v)", len(periods), periods)
+	}
+
+	if !periods[0].Start.Equal(within.Start) {
+		t.Errorf("expected first period to start at %v; got %v", within.Start, periods[0].Start)
+	}
+
+	if !periods[len(periods)-1].End.Equal(within.End) {
+		t.Errorf("expected last period to end at %v; got %v", within.End, periods[len(periods)-1].End)
+	}
+}
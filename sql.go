@@ -0,0 +1,205 @@
+package timefn
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// rangeTimeLayout is the layout used to encode range boundaries. PostgreSQL
+// stores timestamps with microsecond precision.
+const rangeTimeLayout = "2006-01-02 15:04:05.999999-07:00"
+
+// rangeTimeLayouts are the layouts accepted when decoding range boundaries.
+// They cover the output formats of PostgreSQL's tstzrange and tsrange types.
+// Boundaries without zone information are interpreted as UTC.
+var rangeTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00:00",
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// Value implements [driver.Valuer]. The period is encoded as a PostgreSQL range
+// literal with an inclusive start and an exclusive end, e.g.
+// `["2023-01-01 00:00:00+00:00","2023-02-01 00:00:00+00:00")`, which can be
+// stored in tstzrange columns. Boundaries are truncated to microsecond
+// precision. Open boundaries are encoded as unbounded, and the empty period is
+// encoded as NULL.
+func (p Period) Value() (driver.Value, error) {
+	if p.IsZero() {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	b.WriteByte('[')
+	if !p.Start.IsZero() {
+		b.WriteString(`"` + p.Start.Format(rangeTimeLayout) + `"`)
+	}
+	b.WriteByte(',')
+	if !p.End.IsZero() {
+		b.WriteString(`"` + p.End.Format(rangeTimeLayout) + `"`)
+	}
+	b.WriteByte(')')
+
+	return b.String(), nil
+}
+
+// Scan implements [sql.Scanner]. It decodes PostgreSQL tstzrange and tsrange
+// literals such as `["2023-01-01 00:00:00+00","2023-02-01 00:00:00+00")`.
+// Because a [Period] has an inclusive start and an exclusive end, an exclusive
+// lower bound and an inclusive upper bound are both moved forward by one
+// microsecond, the resolution of PostgreSQL timestamps. Unbounded and
+// infinite boundaries decode into open boundaries, and NULL as well as empty
+// ranges decode into the empty period. Boundaries without zone information are
+// interpreted as UTC. Values that are not range literals are decoded using
+// [Period.UnmarshalText].
+func (p *Period) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*p = Period{}
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("scan period: unsupported type %T", src)
+	}
+
+	s = strings.TrimSpace(s)
+	if s == "" || strings.EqualFold(s, "empty") {
+		*p = Period{}
+		return nil
+	}
+
+	if s[0] != '[' && s[0] != '(' {
+		return p.UnmarshalText([]byte(s))
+	}
+
+	parsed, err := parseRange(s)
+	if err != nil {
+		return fmt.Errorf("scan period: %w", err)
+	}
+	*p = parsed
+
+	return nil
+}
+
+// Scan implements [sql.Scanner]. It decodes the period like [Period.Scan] and
+// validates the result using [Period.Validate], so NULL, empty and inverted
+// ranges are rejected.
+func (p *StrictPeriod) Scan(src any) error {
+	var decoded Period
+	if err := decoded.Scan(src); err != nil {
+		return err
+	}
+
+	if err := decoded.Validate(); err != nil {
+		return fmt.Errorf("scan period: %w", err)
+	}
+
+	p.Period = decoded
+
+	return nil
+}
+
+func parseRange(s string) (Period, error) {
+	if len(s) < 3 {
+		return Period{}, fmt.Errorf("invalid range literal %q", s)
+	}
+
+	lowerInclusive := s[0] == '['
+	upperInclusive := s[len(s)-1] == ']'
+	if last := s[len(s)-1]; last != ']' && last != ')' {
+		return Period{}, fmt.Errorf("invalid range literal %q: missing closing bracket", s)
+	}
+
+	bounds, err := splitRange(s[1 : len(s)-1])
+	if err != nil {
+		return Period{}, fmt.Errorf("invalid range literal %q: %w", s, err)
+	}
+
+	var out Period
+
+	if out.Start, err = parseRangeBound(bounds[0]); err != nil {
+		return Period{}, fmt.Errorf("invalid range literal %q: lower bound: %w", s, err)
+	}
+
+	if out.End, err = parseRangeBound(bounds[1]); err != nil {
+		return Period{}, fmt.Errorf("invalid range literal %q: upper bound: %w", s, err)
+	}
+
+	if !lowerInclusive && !out.Start.IsZero() {
+		out.Start = out.Start.Add(time.Microsecond)
+	}
+
+	if upperInclusive && !out.End.IsZero() {
+		out.End = out.End.Add(time.Microsecond)
+	}
+
+	return out, nil
+}
+
+// splitRange splits the inner part of a range literal into its lower and
+// upper bound, removing double quotes and backslash escapes.
+func splitRange(s string) ([2]string, error) {
+	var (
+		out     [2]string
+		current strings.Builder
+		idx     int
+		quoted  bool
+	)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			i++
+			current.WriteByte(s[i])
+		case c == '"':
+			quoted = !quoted
+		case c == ',' && !quoted:
+			if idx > 0 {
+				return out, fmt.Errorf("too many bounds")
+			}
+			out[idx] = current.String()
+			current.Reset()
+			idx++
+		default:
+			current.WriteByte(c)
+		}
+	}
+
+	if quoted {
+		return out, fmt.Errorf("unterminated quote")
+	}
+
+	if idx != 1 {
+		return out, fmt.Errorf("missing bound separator")
+	}
+	out[1] = current.String()
+
+	return out, nil
+}
+
+func parseRangeBound(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.EqualFold(s, "infinity") || strings.EqualFold(s, "-infinity") {
+		return time.Time{}, nil
+	}
+
+	var err error
+	for _, layout := range rangeTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
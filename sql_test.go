@@ -0,0 +1,144 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestPeriod_Value(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	feb1 := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		period timefn.Period
+		want   any
+	}{
+		{
+			name:   "closed",
+			period: timefn.Period{Start: jan1, End: feb1.Add(1500 * time.Nanosecond)},
+			want:   `["2023-01-01 00:00:00+00:00","2023-02-01 00:00:00.000001+00:00")`,
+		},
+		{
+			name:   "open end",
+			period: timefn.Period{Start: jan1},
+			want:   `["2023-01-01 00:00:00+00:00",)`,
+		},
+		{
+			name:   "empty",
+			period: timefn.Period{},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.period.Value()
+			if err != nil {
+				t.Fatalf("Value() failed: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_Scan(t *testing.T) {
+	jan1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	feb1 := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		src     any
+		want    timefn.Period
+		wantErr bool
+	}{
+		{name: "tstzrange", src: `["2023-01-01 00:00:00+00","2023-02-01 00:00:00+00")`, want: timefn.Period{Start: jan1, End: feb1}},
+		{name: "tstzrange with offset", src: []byte(`["2023-01-01 01:00:00+01","2023-02-01 05:30:00.5+05:30")`), want: timefn.Period{Start: jan1, End: feb1.Add(500 * time.Millisecond)}},
+		{name: "tsrange", src: `["2023-01-01 00:00:00","2023-02-01 00:00:00")`, want: timefn.Period{Start: jan1, End: feb1}},
+		{name: "exclusive lower bound", src: `("2023-01-01 00:00:00+00","2023-02-01 00:00:00+00")`, want: timefn.Period{Start: jan1.Add(time.Microsecond), End: feb1}},
+		{name: "inclusive upper bound", src: `["2023-01-01 00:00:00+00","2023-02-01 00:00:00+00"]`, want: timefn.Period{Start: jan1, End: feb1.Add(time.Microsecond)}},
+		{name: "unbounded", src: `["2023-01-01 00:00:00+00",)`, want: timefn.Period{Start: jan1}},
+		{name: "infinity", src: `[-infinity,"2023-02-01 00:00:00+00")`, want: timefn.Period{End: feb1}},
+		{name: "empty", src: "empty", want: timefn.Period{}},
+		{name: "null", src: nil, want: timefn.Period{}},
+		{name: "ISO interval", src: "2023-01-01T00:00:00Z/P1M", want: timefn.Period{Start: jan1, End: feb1}},
+		{name: "missing separator", src: `["2023-01-01 00:00:00+00")`, wantErr: true},
+		{name: "invalid time", src: `["foo",)`, wantErr: true},
+		{name: "unsupported type", src: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got timefn.Period
+			err := got.Scan(tt.src)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Scan(%v) should fail; got %v", tt.src, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Scan(%v) failed: %v", tt.src, err)
+			}
+
+			if !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) {
+				t.Errorf("Scan(%v) = %v, want %v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrictPeriod_Scan(t *testing.T) {
+	var p timefn.StrictPeriod
+	if err := p.Scan(`["2023-01-01 00:00:00+00","2023-02-01 00:00:00+00")`); err != nil {
+		t.Fatalf("Scan() failed: %v", err)
+	}
+
+	want := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if !p.Start.Equal(want.Start) || !p.End.Equal(want.End) {
+		t.Errorf("Scan() = %v, want %v", p.Period, want)
+	}
+
+	for _, src := range []any{
+		nil,
+		"empty",
+		`["2023-02-01 00:00:00+00","2023-01-01 00:00:00+00")`,
+		`["2023-01-01 00:00:00+00",)`,
+	} {
+		var p timefn.StrictPeriod
+		if err := p.Scan(src); err == nil {
+			t.Errorf("Scan(%v) should fail; got %v", src, p.Period)
+		}
+	}
+}
+
+func TestPeriod_Value_roundtrip(t *testing.T) {
+	p := timefn.Period{
+		Start: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.FixedZone("", 3600)),
+		End:   time.Date(2023, time.February, 1, 0, 0, 0, 123456000, time.UTC),
+	}
+
+	v, err := p.Value()
+	if err != nil {
+		t.Fatalf("Value() failed: %v", err)
+	}
+
+	var got timefn.Period
+	if err := got.Scan(v); err != nil {
+		t.Fatalf("Scan(%v) failed: %v", v, err)
+	}
+
+	if !got.Start.Equal(p.Start) || !got.End.Equal(p.End) {
+		t.Errorf("Scan(%v) = %v, want %v", v, got, p)
+	}
+}
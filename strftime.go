@@ -0,0 +1,198 @@
+package timefn
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// strftimeLayouts are the strftime directives that have an equivalent
+// [time.Time.Format] layout. They are supported by [Strftime] and [Strptime].
+var strftimeLayouts = map[byte]string{
+	'a': "Mon",
+	'A': "Monday",
+	'b': "Jan",
+	'B': "January",
+	'c': "Mon Jan _2 15:04:05 2006",
+	'd': "02",
+	'D': "01/02/06",
+	'e': "_2",
+	'F': "2006-01-02",
+	'h': "Jan",
+	'H': "15",
+	'I': "03",
+	'j': "002",
+	'm': "01",
+	'M': "04",
+	'p': "PM",
+	'P': "pm",
+	'r': "03:04:05 PM",
+	'R': "15:04",
+	'S': "05",
+	'T': "15:04:05",
+	'x': "01/02/06",
+	'X': "15:04:05",
+	'y': "06",
+	'Y': "2006",
+	'z': "-0700",
+	'Z': "MST",
+}
+
+// strftimeFuncs are the strftime directives without an equivalent layout.
+// They are supported by [Strftime] only.
+var strftimeFuncs = map[byte]func(time.Time) string{
+	'C': func(t time.Time) string { return fmt.Sprintf("%02d", t.Year()/100) },
+	'f': func(t time.Time) string { return fmt.Sprintf("%06d", t.Nanosecond()/1e3) },
+	'g': func(t time.Time) string { y, _ := t.ISOWeek(); return fmt.Sprintf("%02d", y%100) },
+	'G': func(t time.Time) string { y, _ := t.ISOWeek(); return strconv.Itoa(y) },
+	'k': func(t time.Time) string { return fmt.Sprintf("%2d", t.Hour()) },
+	'l': func(t time.Time) string { return fmt.Sprintf("%2d", (t.Hour()+11)%12+1) },
+	'L': func(t time.Time) string { return fmt.Sprintf("%03d", t.Nanosecond()/1e6) },
+	'N': func(t time.Time) string { return fmt.Sprintf("%09d", t.Nanosecond()) },
+	's': func(t time.Time) string { return strconv.FormatInt(t.Unix(), 10) },
+	'u': func(t time.Time) string { return strconv.Itoa((int(t.Weekday())+6)%7 + 1) },
+	'U': func(t time.Time) string { return fmt.Sprintf("%02d", (t.YearDay()+6-int(t.Weekday()))/7) },
+	'V': func(t time.Time) string { _, w := t.ISOWeek(); return fmt.Sprintf("%02d", w) },
+	'w': func(t time.Time) string { return strconv.Itoa(int(t.Weekday())) },
+	'W': func(t time.Time) string { return fmt.Sprintf("%02d", (t.YearDay()+6-(int(t.Weekday())+6)%7)/7) },
+}
+
+// fractionLayouts are the layouts of the strftime directives for fractional
+// seconds. They are only valid directly after a "." or ",".
+var fractionLayouts = map[byte]string{
+	'f': "000000",
+	'L': "000",
+	'N': "000000000",
+}
+
+// strftimeLiterals are the strftime directives that print a literal.
+var strftimeLiterals = map[byte]string{
+	'%': "%",
+	'n': "\n",
+	't': "\t",
+}
+
+// Strftime formats t using a C, Python or Ruby style strftime format such as
+// "%Y-%m-%d %H:%M:%S". Besides the directives of C99, it supports the common
+// extensions "%e", "%k", "%l", "%P", "%s" and "%:z", Python's "%f"
+// (microseconds), and Ruby's "%L" (milliseconds) and "%N" (nanoseconds). Names
+// of months and weekdays are English, and "%c", "%x" and "%X" use the formats
+// of the C locale. Unknown directives are printed unchanged.
+func Strftime(t time.Time, format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			b.WriteByte(format[i])
+			continue
+		}
+
+		i++
+		c := format[i]
+		if c == ':' && i+1 < len(format) && format[i+1] == 'z' {
+			i++
+			b.WriteString(t.Format("-07:00"))
+			continue
+		}
+
+		if layout, ok := strftimeLayouts[c]; ok {
+			b.WriteString(t.Format(layout))
+		} else if fn, ok := strftimeFuncs[c]; ok {
+			b.WriteString(fn(t))
+		} else if lit, ok := strftimeLiterals[c]; ok {
+			b.WriteString(lit)
+		} else {
+			b.WriteByte('%')
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// Strptime parses value using a strftime format, as the inverse of
+// [Strftime]. The format is translated to a [time.Time.Format] layout and
+// parsed using [time.ParseInLocation], so times without zone information are
+// interpreted in loc. Directives that have no equivalent layout, such as "%s"
+// or "%V", are not supported, with the exception of "%f", "%L" and "%N",
+// which are supported directly after a "." or ",". Strptime also returns an
+// error if literal text in the format could be mistaken for a layout element,
+// such as "Jan".
+func Strptime(format, value string, loc *time.Location) (time.Time, error) {
+	layout, err := strftimeLayout(format)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse %q using strftime format %q: %w", value, format, err)
+	}
+
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse %q using strftime format %q: %w", value, format, err)
+	}
+
+	return t, nil
+}
+
+// layoutProbe is a time that changes the output of every layout element, so
+// that formatting it reveals whether literal text contains layout elements.
+var layoutProbe = time.Date(2009, time.November, 17, 20, 34, 58, 651387237, time.FixedZone("PROBE", 3600))
+
+// strftimeLayout translates a strftime format into a [time.Time.Format]
+// layout.
+func strftimeLayout(format string) (string, error) {
+	var (
+		b       strings.Builder
+		literal strings.Builder
+	)
+
+	flush := func() error {
+		lit := literal.String()
+		literal.Reset()
+		if layoutProbe.Format(lit) != lit {
+			return fmt.Errorf("literal %q is ambiguous", lit)
+		}
+		b.WriteString(lit)
+		return nil
+	}
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			literal.WriteByte(format[i])
+			continue
+		}
+
+		i++
+		c := format[i]
+		if lit, ok := strftimeLiterals[c]; ok {
+			literal.WriteString(lit)
+			continue
+		}
+
+		var layout string
+		switch {
+		case c == ':' && i+1 < len(format) && format[i+1] == 'z':
+			i++
+			layout = "-07:00"
+		case c == 'f' || c == 'L' || c == 'N':
+			lit := literal.String()
+			if !strings.HasSuffix(lit, ".") && !strings.HasSuffix(lit, ",") {
+				return "", fmt.Errorf("%%%c must follow a \".\" or \",\"", c)
+			}
+			layout = fractionLayouts[c]
+		default:
+			var ok bool
+			if layout, ok = strftimeLayouts[c]; !ok {
+				return "", fmt.Errorf("unsupported directive %%%c", c)
+			}
+		}
+
+		if err := flush(); err != nil {
+			return "", err
+		}
+		b.WriteString(layout)
+	}
+
+	if err := flush(); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,92 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestStrftime(t *testing.T) {
+	tm := time.Date(2024, time.March, 4, 7, 5, 9, 123456789, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: "%Y-%m-%d %H:%M:%S", want: "2024-03-04 07:05:09"},
+		{format: "%a, %d %b %Y", want: "Mon, 04 Mar 2024"},
+		{format: "%A %B %e", want: "Monday March  4"},
+		{format: "%I:%M %p / %l%P", want: "07:05 AM /  7am"},
+		{format: "%F %T %z %:z %Z", want: "2024-03-04 07:05:09 +0100 +01:00 CET"},
+		{format: "%S.%f / %L / %N", want: "09.123456 / 123 / 123456789"},
+		{format: "%j %u %w %U %W %V %G %g %C", want: "064 1 1 09 10 10 2024 24 20"},
+		{format: "%k|%s", want: " 7|1709532309"},
+		{format: "100%% %q%", want: "100% %q%"},
+		{format: "%D %R%n%t", want: "03/04/24 07:05\n\t"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := timefn.Strftime(tm, tt.format); got != tt.want {
+				t.Errorf("Strftime(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrptime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		format  string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{format: "%Y-%m-%d %H:%M:%S", value: "2024-03-04 07:05:09", want: time.Date(2024, time.March, 4, 7, 5, 9, 0, berlin)},
+		{format: "%d.%m.%Y", value: "04.03.2024", want: time.Date(2024, time.March, 4, 0, 0, 0, 0, berlin)},
+		{format: "%Y-%m-%dT%H:%M:%S.%f%:z", value: "2024-03-04T07:05:09.123456+02:00", want: time.Date(2024, time.March, 4, 5, 5, 9, 123456000, time.UTC)},
+		{format: "%b %e, %Y at %I%p", value: "Mar  4, 2024 at 07PM", want: time.Date(2024, time.March, 4, 19, 0, 0, 0, berlin)},
+		{format: "%%Y=%Y", value: "%Y=2024", want: time.Date(2024, time.January, 1, 0, 0, 0, 0, berlin)},
+		{format: "%s", value: "1709532309", wantErr: true},
+		{format: "%H:%M:%S%f", value: "07:05:09123456", wantErr: true},
+		{format: "Jan %Y", value: "Jan 2024", wantErr: true},
+		{format: "100%% %Y", value: "100% 2024", wantErr: true},
+		{format: "%Y-%m-%d", value: "2024/03/04", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := timefn.Strptime(tt.format, tt.value, berlin)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Strptime(%q, %q) should fail; got %v", tt.format, tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Strptime(%q, %q) failed: %v", tt.format, tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Strptime(%q, %q) = %v; want %v", tt.format, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrptime_roundTrip(t *testing.T) {
+	tm := time.Date(2024, time.March, 4, 7, 5, 9, 0, time.UTC)
+	format := "%A, %d %B %Y %H:%M:%S %z"
+
+	got, err := timefn.Strptime(format, timefn.Strftime(tm, format), time.UTC)
+	if err != nil {
+		t.Fatalf("Strptime() failed: %v", err)
+	}
+	if !got.Equal(tm) {
+		t.Errorf("round trip = %v; want %v", got, tm)
+	}
+}
@@ -0,0 +1,67 @@
+package timefn
+
+import (
+	"sync"
+	"time"
+)
+
+// SyncPeriodSet is a mutable [PeriodSet] that is safe for concurrent use by
+// multiple goroutines. Mutations build a new set and replace the old one, so a
+// [SyncPeriodSet.Snapshot] is a consistent view that later mutations do not
+// affect. The zero value is an empty set ready to use. A SyncPeriodSet must not
+// be copied after first use.
+type SyncPeriodSet struct {
+	mux sync.RWMutex
+	set PeriodSet
+}
+
+// NewSyncPeriodSet returns a [SyncPeriodSet] that initially covers the given
+// periods.
+func NewSyncPeriodSet(periods ...Period) *SyncPeriodSet {
+	return &SyncPeriodSet{set: NewPeriodSet(periods...)}
+}
+
+// Add adds the given periods to the set.
+func (s *SyncPeriodSet) Add(periods ...Period) {
+	added := NewPeriodSet(periods...)
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.set = s.set.Union(added)
+}
+
+// Remove removes the given periods from the set.
+func (s *SyncPeriodSet) Remove(periods ...Period) {
+	removed := NewPeriodSet(periods...)
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.set = s.set.Difference(removed)
+}
+
+// Update replaces the set with the result of fn, which is called with the
+// current set while holding the write lock. Use it for read-modify-write
+// operations that must not interleave with other mutations.
+func (s *SyncPeriodSet) Update(fn func(PeriodSet) PeriodSet) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.set = fn(s.set)
+}
+
+// Snapshot returns the current state of the set. The returned [PeriodSet] is
+// immutable and is not affected by later mutations.
+func (s *SyncPeriodSet) Snapshot() PeriodSet {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.set
+}
+
+// Contains returns whether t is currently contained in the set.
+func (s *SyncPeriodSet) Contains(t time.Time) bool {
+	return s.Snapshot().Contains(t)
+}
+
+// OverlapsWith returns whether p currently overlaps with the set.
+func (s *SyncPeriodSet) OverlapsWith(p Period) bool {
+	return s.Snapshot().OverlapsWith(p)
+}
@@ -0,0 +1,66 @@
+package timefn_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestSyncPeriodSet(t *testing.T) {
+	at := func(h int) time.Time {
+		return time.Date(2023, time.January, 1, h, 0, 0, 0, time.UTC)
+	}
+
+	var set timefn.SyncPeriodSet
+	set.Add(timefn.Period{Start: at(8), End: at(12)})
+
+	before := set.Snapshot()
+	set.Remove(timefn.Period{Start: at(10), End: at(11)})
+
+	if !before.Contains(at(10)) {
+		t.Errorf("expected snapshot to be unaffected by later mutations")
+	}
+
+	if set.Contains(at(10)) {
+		t.Errorf("expected %v to be removed", at(10))
+	}
+
+	if !set.OverlapsWith(timefn.Period{Start: at(11), End: at(13)}) {
+		t.Errorf("expected set to overlap with 11:00-13:00")
+	}
+
+	set.Update(func(s timefn.PeriodSet) timefn.PeriodSet {
+		return s.Union(timefn.NewPeriodSet(timefn.Period{Start: at(10), End: at(11)}))
+	})
+
+	want := timefn.NewPeriodSet(timefn.Period{Start: at(8), End: at(12)})
+	if got := set.Snapshot(); !got.Equal(want) {
+		t.Errorf("Snapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestSyncPeriodSet_concurrent(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	set := timefn.NewSyncPeriodSet()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				s := start.Add(time.Duration(i*100+j*2) * time.Hour)
+				set.Add(timefn.Period{Start: s, End: s.Add(time.Hour)})
+				set.Contains(s)
+				set.Snapshot().Len()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := set.Snapshot().Len(); got != 400 {
+		t.Errorf("expected 400 periods; got %d", got)
+	}
+}
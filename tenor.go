@@ -0,0 +1,72 @@
+package timefn
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTenor parses a tenor as used in treasury and money markets, such as
+// "1W", "3M", "1Y" or "ON" (overnight), into a [CalendarDuration]. A tenor is a
+// sequence of whole numbers followed by one of the units "D" (days), "W"
+// (weeks), "M" (months) and "Y" (years), e.g. "1Y6M". "ON" is one day. Units
+// are case-insensitive.
+func ParseTenor(s string) (CalendarDuration, error) {
+	var out CalendarDuration
+
+	input := s
+	s = strings.ToUpper(s)
+	if s == "ON" {
+		return CalendarDuration{Days: 1}, nil
+	}
+
+	if s == "" {
+		return out, fmt.Errorf("invalid tenor %q", input)
+	}
+
+	for len(s) > 0 {
+		i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 {
+			return out, fmt.Errorf("invalid tenor %q", input)
+		}
+
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return out, fmt.Errorf("invalid tenor %q: %w", input, err)
+		}
+
+		switch s[i] {
+		case 'D':
+			out.Days += n
+		case 'W':
+			out.Days += 7 * n
+		case 'M':
+			out.Months += n
+		case 'Y':
+			out.Years += n
+		default:
+			return out, fmt.Errorf("invalid tenor %q: unknown unit %q", input, s[i])
+		}
+		s = s[i+1:]
+	}
+
+	return out, nil
+}
+
+// AddTenor returns the end date of a tenor that starts at the given date. Years
+// and months are added first; if the resulting month is shorter than the day
+// of start, the end date is clamped to its last day, so that one month after
+// January 31 is February 28 or 29. Days are added after that. The end date is
+// then moved to a business day of cal using the given [RollConvention], as
+// described by [RollDate]. The clock component of the tenor is ignored.
+func AddTenor(start Date, tenor CalendarDuration, convention RollConvention, cal *BusinessCalendar) Date {
+	return RollDate(start.addClamped(tenor, 1), convention, cal)
+}
+
+// TenorPeriod returns the period of a tenor that starts at the given date in
+// loc, from the start of the start date up to the start of the end date
+// returned by [AddTenor].
+func TenorPeriod(start Date, tenor CalendarDuration, convention RollConvention, cal *BusinessCalendar, loc *time.Location) Period {
+	return Period{Start: start.In(loc), End: AddTenor(start, tenor, convention, cal).In(loc)}
+}
@@ -0,0 +1,91 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTenor(t *testing.T) {
+	tests := []struct {
+		tenor   string
+		want    timefn.CalendarDuration
+		wantErr bool
+	}{
+		{tenor: "ON", want: timefn.CalendarDuration{Days: 1}},
+		{tenor: "2D", want: timefn.CalendarDuration{Days: 2}},
+		{tenor: "1W", want: timefn.CalendarDuration{Days: 7}},
+		{tenor: "3M", want: timefn.CalendarDuration{Months: 3}},
+		{tenor: "1y", want: timefn.CalendarDuration{Years: 1}},
+		{tenor: "1Y6M", want: timefn.CalendarDuration{Years: 1, Months: 6}},
+		{tenor: "", wantErr: true},
+		{tenor: "M", wantErr: true},
+		{tenor: "3", wantErr: true},
+		{tenor: "3Q", wantErr: true},
+		{tenor: "-1M", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tenor, func(t *testing.T) {
+			got, err := timefn.ParseTenor(tt.tenor)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseTenor(%q) should fail", tt.tenor)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTenor(%q) failed: %v", tt.tenor, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddTenor(t *testing.T) {
+	cal := timefn.NewBusinessCalendar()
+
+	tests := []struct {
+		name       string
+		start      string
+		tenor      string
+		convention timefn.RollConvention
+		want       string
+	}{
+		{name: "overnight", start: "2023-03-15", tenor: "ON", convention: timefn.Following, want: "2023-03-16"},
+		{name: "overnight over weekend", start: "2023-03-17", tenor: "ON", convention: timefn.Following, want: "2023-03-20"},
+		{name: "one week", start: "2023-03-15", tenor: "1W", convention: timefn.Following, want: "2023-03-22"},
+		{name: "month end clamped", start: "2023-01-31", tenor: "1M", convention: timefn.Following, want: "2023-02-28"},
+		{name: "leap year", start: "2024-01-31", tenor: "1M", convention: timefn.Following, want: "2024-02-29"},
+		{name: "modified following", start: "2023-03-31", tenor: "3M", convention: timefn.ModifiedFollowing, want: "2023-06-30"},
+		{name: "rolled into next month", start: "2023-01-30", tenor: "3M", convention: timefn.Following, want: "2023-05-01"},
+		{name: "modified following stays in month", start: "2023-01-30", tenor: "3M", convention: timefn.ModifiedFollowing, want: "2023-04-28"},
+		{name: "years", start: "2024-02-29", tenor: "1Y", convention: timefn.Preceding, want: "2025-02-28"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, err := timefn.ParseDate(tt.start)
+			if err != nil {
+				t.Fatalf("parse date: %v", err)
+			}
+			tenor, err := timefn.ParseTenor(tt.tenor)
+			if err != nil {
+				t.Fatalf("parse tenor: %v", err)
+			}
+			if got := timefn.AddTenor(start, tenor, tt.convention, cal); got.String() != tt.want {
+				t.Errorf("AddTenor(%s, %s) = %s; want %s", tt.start, tt.tenor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTenorPeriod(t *testing.T) {
+	start := timefn.Date{Year: 2023, Month: time.March, Day: 17}
+	p := timefn.TenorPeriod(start, timefn.CalendarDuration{Days: 1}, timefn.Following, timefn.NewBusinessCalendar(), time.UTC)
+
+	assert.Equal(t, time.Date(2023, time.March, 17, 0, 0, 0, 0, time.UTC), p.Start)
+	assert.Equal(t, time.Date(2023, time.March, 20, 0, 0, 0, 0, time.UTC), p.End)
+}
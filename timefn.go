@@ -1,6 +1,9 @@
 package timefn
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // StartOfSecond returns a new time.Time value representing the start of the
 // second for the given time. The returned time will have its nanosecond field
@@ -65,19 +68,25 @@ func EndOfDay(t time.Time) time.Time {
 }
 
 // StartOfWeek returns the start of the week for a given time. The week starts
-// on Sunday as per Go's time package definition. The returned time has the same
-// location and date but the hour, minute, second, and nanosecond are set to
-// their zero values.
-func StartOfWeek(t time.Time) time.Time {
-	return StartOfDay(t.AddDate(0, 0, -int(t.Weekday())))
+// on Sunday as per Go's time package definition, unless configured otherwise
+// by the given options, e.g.
+//
+//	timefn.StartOfWeek(t, timefn.WeekStartsOn(time.Saturday))
+//
+// The returned time has the same location and date but the hour, minute,
+// second, and nanosecond are set to their zero values. Use a [Calendar] to
+// carry the configuration around instead.
+func StartOfWeek(t time.Time, opts ...CalendarOption) time.Time {
+	return NewCalendar(opts...).StartOfWeek(t)
 }
 
 // EndOfWeek returns the end of the week for a given time. The end of the week
 // is defined as 23:59:59 on the last day of the week, which depends on the
-// Weekday of the input time. The returned time is in the same location as the
+// Weekday of the input time and the week start configured by the given
+// options, see [StartOfWeek]. The returned time is in the same location as the
 // input time.
-func EndOfWeek(t time.Time) time.Time {
-	return EndOfDay(t.AddDate(0, 0, 6-int(t.Weekday())))
+func EndOfWeek(t time.Time, opts ...CalendarOption) time.Time {
+	return NewCalendar(opts...).EndOfWeek(t)
 }
 
 // StartOfISOWeek returns a new time.Time representing the start of the ISO 8601
@@ -85,7 +94,7 @@ func EndOfWeek(t time.Time) time.Time {
 // returned time has the same location and year, month, and day fields as t but
 // the hour, minute, second, and nanosecond fields are all set to zero.
 func StartOfISOWeek(t time.Time) time.Time {
-	return StartOfDay(t.AddDate(0, 0, -int((t.Weekday()+6)%7)))
+	return StartOfWeekOn(t, time.Monday)
 }
 
 // EndOfISOWeek returns the last instant within the same ISO week as the
@@ -93,7 +102,27 @@ func StartOfISOWeek(t time.Time) time.Time {
 // returned time will be at the end of the day, just before midnight, in the
 // location of the provided time.
 func EndOfISOWeek(t time.Time) time.Time {
-	return EndOfDay(t.AddDate(0, 0, 6-int((t.Weekday()+6)%7)))
+	return EndOfWeekOn(t, time.Monday)
+}
+
+// StartOfWeekOn returns the start of the week for a given time, for weeks that
+// start on the given weekday, e.g. [time.Saturday]. The returned time has the
+// same location and the time of day set to midnight.
+func StartOfWeekOn(t time.Time, weekStart time.Weekday) time.Time {
+	return StartOfDay(t.AddDate(0, 0, -daysSinceWeekStart(t, weekStart)))
+}
+
+// EndOfWeekOn returns the last instant of the week for a given time, for weeks
+// that start on the given weekday. The week ends just before midnight on the
+// day before weekStart, in the location of t.
+func EndOfWeekOn(t time.Time, weekStart time.Weekday) time.Time {
+	return EndOfDay(t.AddDate(0, 0, 6-daysSinceWeekStart(t, weekStart)))
+}
+
+// daysSinceWeekStart returns the number of days from the last weekStart up to
+// the day of t, between 0 and 6.
+func daysSinceWeekStart(t time.Time, weekStart time.Weekday) int {
+	return (int(t.Weekday()) - int(weekStart) + 7) % 7
 }
 
 // StartOfMonth returns a new instance of [time.Time] set to the first day of
@@ -125,6 +154,76 @@ func EndOfYear(t time.Time) time.Time {
 	return StartOfYear(t).AddDate(1, 0, 0).Add(-time.Nanosecond)
 }
 
+// StartOfHalfYear returns the start of the half-year of the given time, which
+// is either January 1st or July 1st at midnight in t's location.
+func StartOfHalfYear(t time.Time) time.Time {
+	month := time.January
+	if t.Month() >= time.July {
+		month = time.July
+	}
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfHalfYear returns the latest possible time within the same half-year as
+// the given time [t]. The returned time is one nanosecond before the start of
+// the next half-year.
+func EndOfHalfYear(t time.Time) time.Time {
+	return StartOfHalfYear(t).AddDate(0, 6, 0).Add(-time.Nanosecond)
+}
+
+// HalfYearPeriod returns the given half (1 for H1, 2 for H2) of the given year
+// as a [Period] in UTC. Halves outside of 1 and 2 continue into the adjacent
+// years, so half 3 is H1 of the next year.
+func HalfYearPeriod(year, half int) Period {
+	start := time.Date(year, time.Month(6*(half-1)+1), 1, 0, 0, 0, 0, time.UTC)
+	return Period{Start: start, End: start.AddDate(0, 6, 0)}
+}
+
+// DaysInMonth returns the number of days in the given month of the given year,
+// e.g. 29 for February 2024.
+func DaysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// DaysInYear returns the number of days in the given year, which is 366 for
+// leap years and 365 otherwise.
+func DaysInYear(year int) int {
+	if IsLeapYear(year) {
+		return 366
+	}
+	return 365
+}
+
+// IsLeapYear reports whether the given year is a leap year in the Gregorian
+// calendar.
+func IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// DiffInCalendarDays returns the number of calendar days from b to a, i.e. the
+// number of midnights that lie between them, as seen in the location of a.
+// Unlike a.Sub(b), it counts 23:00 and 01:00 of the next day as one day apart
+// and is not affected by DST days that are shorter or longer than 24 hours.
+// The result is negative if a lies before b.
+func DiffInCalendarDays(a, b time.Time) int {
+	return DateOf(a).Sub(DateOf(b.In(a.Location())))
+}
+
+// DiffInMonths returns the number of calendar months from b to a, i.e. the
+// number of month boundaries that lie between them, as seen in the location of
+// a. January 31 and February 1 are one month apart. The result is negative if
+// a lies before b.
+func DiffInMonths(a, b time.Time) int {
+	return MonthOf(a).Sub(MonthOf(b.In(a.Location())))
+}
+
+// DiffInYears returns the number of calendar years from b to a, i.e. the
+// number of year boundaries that lie between them, as seen in the location of
+// a. The result is negative if a lies before b.
+func DiffInYears(a, b time.Time) int {
+	return a.Year() - b.In(a.Location()).Year()
+}
+
 // Between checks if a given time [t] falls after time [l] and before time [r].
 // Returns true if [t] is between [l] and [r], otherwise returns false.
 func Between(t, l, r time.Time) bool {
@@ -163,3 +262,109 @@ func SameOrAfter(t, l time.Time) bool {
 func AtTime(t time.Time, h, m, s, ns int) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), h, m, s, ns, t.Location())
 }
+
+// WithinLast reports whether t lies within the duration d leading up to the
+// current time, as returned by [Now]. The check is inclusive on both ends, so a
+// time exactly d before now, or exactly now, is considered within the last d.
+// Times in the future are never within the last d.
+func WithinLast(t time.Time, d time.Duration) bool {
+	return withinLast(Now(), t, d)
+}
+
+// WithinLastContext is like [WithinLast], but reads the current time from
+// [NowFromContext].
+func WithinLastContext(ctx context.Context, t time.Time, d time.Duration) bool {
+	return withinLast(NowFromContext(ctx), t, d)
+}
+
+func withinLast(now, t time.Time, d time.Duration) bool {
+	return BetweenInclusive(t, now.Add(-d), now)
+}
+
+// WithinNext reports whether t lies within the duration d following the
+// current time, as returned by [Now]. The check is inclusive on both ends, so a
+// time exactly now, or exactly d after now, is considered within the next d.
+// Times in the past are never within the next d.
+func WithinNext(t time.Time, d time.Duration) bool {
+	return withinNext(Now(), t, d)
+}
+
+// WithinNextContext is like [WithinNext], but reads the current time from
+// [NowFromContext].
+func WithinNextContext(ctx context.Context, t time.Time, d time.Duration) bool {
+	return withinNext(NowFromContext(ctx), t, d)
+}
+
+func withinNext(now, t time.Time, d time.Duration) bool {
+	return BetweenInclusive(t, now, now.Add(d))
+}
+
+// IsExpired reports whether t is at or before the current time, as returned by
+// [Now]. It is meant for expiration timestamps of tokens, contracts and similar
+// resources that become invalid once their expiry time is reached.
+func IsExpired(t time.Time) bool {
+	return SameOrBefore(t, Now())
+}
+
+// IsExpiredContext is like [IsExpired], but reads the current time from
+// [NowFromContext].
+func IsExpiredContext(ctx context.Context, t time.Time) bool {
+	return SameOrBefore(t, NowFromContext(ctx))
+}
+
+// TimeUntil returns the duration from the current time, as returned by [Now],
+// until t. The result is negative if t lies in the past. Unlike [time.Until],
+// TimeUntil respects a replaced [Now].
+func TimeUntil(t time.Time) time.Duration {
+	return t.Sub(Now())
+}
+
+// TimeUntilContext is like [TimeUntil], but reads the current time from
+// [NowFromContext].
+func TimeUntilContext(ctx context.Context, t time.Time) time.Duration {
+	return t.Sub(NowFromContext(ctx))
+}
+
+// HourOfWeek returns the hour of the week of t in the given location, ranging
+// from 0 (Sunday 00:00-00:59) to 167 (Saturday 23:00-23:59). Like
+// [StartOfWeek], weeks start on Sunday. The index is based on the wall clock,
+// so on days with a daylight saving time transition an index is either skipped
+// or shared by two consecutive hours.
+func HourOfWeek(t time.Time, loc *time.Location) int {
+	t = t.In(loc)
+	return int(t.Weekday())*24 + t.Hour()
+}
+
+// FromHourOfWeek is the inverse of [HourOfWeek]. It returns the start of the
+// given hour of the week that contains week, as seen in the given location.
+// Indices outside of 0-167 spill over into the previous or next weeks. If the
+// hour does not exist because clocks are moved forward, the result is shifted
+// forward by the length of the gap. If the hour occurs twice because clocks are
+// moved back, the earlier occurrence is returned.
+func FromHourOfWeek(week time.Time, how int, loc *time.Location) time.Time {
+	y, m, d := StartOfWeek(week.In(loc)).Date()
+	t, _ := resolveWallClock(time.Date(y, m, d, how, 0, 0, 0, time.UTC), loc, wallClockConfig{})
+	return t
+}
+
+// MinuteOfDay returns the minute of the day of t in the given location,
+// ranging from 0 (00:00) to 1439 (23:59). The index is based on the wall clock,
+// so it does not count the minutes that have elapsed since midnight: on a
+// 23-hour day some indices are skipped, and on a 25-hour day some indices are
+// shared by two different minutes.
+func MinuteOfDay(t time.Time, loc *time.Location) int {
+	t = t.In(loc)
+	return t.Hour()*60 + t.Minute()
+}
+
+// AtMinuteOfDay is the inverse of [MinuteOfDay]. It returns the start of the
+// given minute of the day of date, as seen in the given location. Minutes
+// outside of 0-1439 spill over into the previous or next days. Minutes that do
+// not exist on a 23-hour day are resolved using the [GapPolicy] and minutes
+// that occur twice on a 25-hour day are resolved using the [AmbiguityPolicy],
+// which can be configured using [OnGap] and [OnAmbiguity]. An error is only
+// returned if one of the policies rejects the minute.
+func AtMinuteOfDay(date time.Time, minutes int, loc *time.Location, opts ...WallClockOption) (time.Time, error) {
+	y, m, d := date.In(loc).Date()
+	return resolveWallClock(time.Date(y, m, d, 0, minutes, 0, 0, time.UTC), loc, newWallClockConfig(opts))
+}
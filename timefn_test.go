@@ -1,6 +1,7 @@
 package timefn_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -137,6 +138,67 @@ func TestEndOfISOWeek(t *testing.T) {
 	}
 }
 
+func TestStartOfWeekOn(t *testing.T) {
+	tests := []struct {
+		Time      time.Time
+		WeekStart time.Weekday
+		Expected  time.Time
+	}{
+		{
+			Time:      time.Date(2020, 3, 30, 15, 15, 15, 15, time.UTC),
+			WeekStart: time.Saturday,
+			Expected:  time.Date(2020, 3, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Time:      time.Date(2020, 3, 28, 15, 15, 15, 15, time.UTC),
+			WeekStart: time.Saturday,
+			Expected:  time.Date(2020, 3, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Time:      time.Date(2020, 3, 1, 15, 15, 15, 15, time.UTC),
+			WeekStart: time.Monday,
+			Expected:  time.Date(2020, 2, 24, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Time:      time.Date(2020, 4, 8, 15, 15, 15, 15, time.UTC),
+			WeekStart: time.Sunday,
+			Expected:  time.Date(2020, 4, 5, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, timefn.StartOfWeekOn(test.Time, test.WeekStart))
+	}
+}
+
+func TestEndOfWeekOn(t *testing.T) {
+	tests := []struct {
+		Time      time.Time
+		WeekStart time.Weekday
+		Expected  time.Time
+	}{
+		{
+			Time:      time.Date(2020, 3, 30, 15, 15, 15, 15, time.UTC),
+			WeekStart: time.Saturday,
+			Expected:  time.Date(2020, 4, 4, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			Time:      time.Date(2020, 4, 3, 15, 15, 15, 15, time.UTC),
+			WeekStart: time.Saturday,
+			Expected:  time.Date(2020, 4, 4, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+		{
+			Time:      time.Date(2020, 3, 1, 15, 15, 15, 15, time.UTC),
+			WeekStart: time.Monday,
+			Expected:  time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, timefn.EndOfWeekOn(test.Time, test.WeekStart))
+	}
+}
+
 func TestStartOfMonth(t *testing.T) {
 	assert.Equal(t, time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), timefn.StartOfMonth(time.Date(2020, 3, 15, 15, 15, 15, 15, time.UTC)))
 }
@@ -153,6 +215,28 @@ func TestEndOfYear(t *testing.T) {
 	assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond), timefn.EndOfYear(time.Date(2020, 3, 15, 15, 15, 15, 15, time.UTC)))
 }
 
+func TestStartOfHalfYear(t *testing.T) {
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), timefn.StartOfHalfYear(time.Date(2020, 6, 30, 23, 59, 59, 0, time.UTC)))
+	assert.Equal(t, time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC), timefn.StartOfHalfYear(time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestEndOfHalfYear(t *testing.T) {
+	assert.Equal(t, time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond), timefn.EndOfHalfYear(time.Date(2020, 3, 15, 15, 15, 15, 15, time.UTC)))
+	assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond), timefn.EndOfHalfYear(time.Date(2020, 9, 15, 15, 15, 15, 15, time.UTC)))
+}
+
+func TestHalfYearPeriod(t *testing.T) {
+	assert.Equal(t, timefn.Period{
+		Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+	}, timefn.HalfYearPeriod(2024, 1))
+
+	assert.Equal(t, timefn.Period{
+		Start: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, timefn.HalfYearPeriod(2024, 2))
+}
+
 func TestBetween(t *testing.T) {
 	tests := []struct {
 		Time     time.Time
@@ -325,3 +409,344 @@ func TestAtTime(t *testing.T) {
 		})
 	}
 }
+
+func TestWithinLast(t *testing.T) {
+	now := time.Date(2020, time.January, 2, 12, 0, 0, 0, time.UTC)
+	defer freezeNow(now)()
+
+	tests := []struct {
+		Time     time.Time
+		Expected bool
+	}{
+		{Time: now, Expected: true},
+		{Time: now.Add(-time.Hour), Expected: true},
+		{Time: now.Add(-24 * time.Hour), Expected: true},
+		{Time: now.Add(-24*time.Hour - time.Nanosecond), Expected: false},
+		{Time: now.Add(time.Nanosecond), Expected: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, timefn.WithinLast(test.Time, 24*time.Hour), test.Time.String())
+	}
+}
+
+func TestWithinNext(t *testing.T) {
+	now := time.Date(2020, time.January, 2, 12, 0, 0, 0, time.UTC)
+	defer freezeNow(now)()
+
+	tests := []struct {
+		Time     time.Time
+		Expected bool
+	}{
+		{Time: now, Expected: true},
+		{Time: now.Add(time.Hour), Expected: true},
+		{Time: now.Add(24 * time.Hour), Expected: true},
+		{Time: now.Add(24*time.Hour + time.Nanosecond), Expected: false},
+		{Time: now.Add(-time.Nanosecond), Expected: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, timefn.WithinNext(test.Time, 24*time.Hour), test.Time.String())
+	}
+}
+
+func freezeNow(t time.Time) func() {
+	prev := timefn.Now
+	timefn.Now = func() time.Time { return t }
+	return func() { timefn.Now = prev }
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Date(2020, time.January, 2, 12, 0, 0, 0, time.UTC)
+	defer freezeNow(now)()
+
+	assert.True(t, timefn.IsExpired(now.Add(-time.Nanosecond)))
+	assert.True(t, timefn.IsExpired(now))
+	assert.False(t, timefn.IsExpired(now.Add(time.Nanosecond)))
+}
+
+func TestTimeUntil(t *testing.T) {
+	now := time.Date(2020, time.January, 2, 12, 0, 0, 0, time.UTC)
+	defer freezeNow(now)()
+
+	assert.Equal(t, time.Hour, timefn.TimeUntil(now.Add(time.Hour)))
+	assert.Equal(t, -time.Hour, timefn.TimeUntil(now.Add(-time.Hour)))
+}
+
+func TestHourOfWeek(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		Time     time.Time
+		Expected int
+	}{
+		{Time: time.Date(2023, time.March, 19, 0, 30, 0, 0, berlin), Expected: 0},
+		{Time: time.Date(2023, time.March, 20, 9, 0, 0, 0, berlin), Expected: 33},
+		{Time: time.Date(2023, time.March, 25, 23, 59, 0, 0, berlin), Expected: 167},
+		{Time: time.Date(2023, time.March, 19, 23, 30, 0, 0, time.UTC), Expected: 24},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, timefn.HourOfWeek(test.Time, berlin), test.Time.String())
+	}
+}
+
+func TestFromHourOfWeek(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		Name     string
+		Week     time.Time
+		How      int
+		Loc      *time.Location
+		Expected time.Time
+	}{
+		{
+			Name:     "regular hour",
+			Week:     time.Date(2023, time.March, 22, 0, 0, 0, 0, berlin),
+			How:      33,
+			Loc:      berlin,
+			Expected: time.Date(2023, time.March, 20, 9, 0, 0, 0, berlin),
+		},
+		{
+			Name:     "nonexistent hour (Berlin)",
+			Week:     time.Date(2023, time.March, 26, 12, 0, 0, 0, berlin),
+			How:      2,
+			Loc:      berlin,
+			Expected: time.Date(2023, time.March, 26, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "nonexistent hour (New York)",
+			Week:     time.Date(2023, time.March, 12, 12, 0, 0, 0, ny),
+			How:      2,
+			Loc:      ny,
+			Expected: time.Date(2023, time.March, 12, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "ambiguous hour (Berlin)",
+			Week:     time.Date(2023, time.October, 29, 12, 0, 0, 0, berlin),
+			How:      2,
+			Loc:      berlin,
+			Expected: time.Date(2023, time.October, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "ambiguous hour (New York)",
+			Week:     time.Date(2023, time.November, 5, 12, 0, 0, 0, ny),
+			How:      1,
+			Loc:      ny,
+			Expected: time.Date(2023, time.November, 5, 5, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got := timefn.FromHourOfWeek(test.Week, test.How, test.Loc)
+			assert.True(t, test.Expected.Equal(got), "expected %v; got %v", test.Expected, got)
+		})
+	}
+
+	for how := 0; how < 168; how++ {
+		week := time.Date(2023, time.March, 22, 0, 0, 0, 0, berlin)
+		assert.Equal(t, how, timefn.HourOfWeek(timefn.FromHourOfWeek(week, how, berlin), berlin))
+	}
+}
+
+func TestMinuteOfDay(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	assert.Equal(t, 0, timefn.MinuteOfDay(time.Date(2023, time.March, 26, 0, 0, 0, 0, berlin), berlin))
+	assert.Equal(t, 3*60+15, timefn.MinuteOfDay(time.Date(2023, time.March, 26, 3, 15, 59, 0, berlin), berlin))
+	assert.Equal(t, 1439, timefn.MinuteOfDay(time.Date(2023, time.March, 26, 23, 59, 0, 0, berlin), berlin))
+	assert.Equal(t, 60, timefn.MinuteOfDay(time.Date(2023, time.March, 26, 0, 0, 0, 0, time.UTC), berlin))
+}
+
+func TestAtMinuteOfDay(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	springForward := time.Date(2023, time.March, 26, 12, 0, 0, 0, berlin)
+	fallBack := time.Date(2023, time.October, 29, 12, 0, 0, 0, berlin)
+
+	tests := []struct {
+		Name     string
+		Date     time.Time
+		Minutes  int
+		Opts     []timefn.WallClockOption
+		Expected time.Time
+		Err      error
+	}{
+		{
+			Name:     "regular minute",
+			Date:     springForward,
+			Minutes:  9*60 + 30,
+			Expected: time.Date(2023, time.March, 26, 9, 30, 0, 0, berlin),
+		},
+		{
+			Name:     "next day",
+			Date:     springForward,
+			Minutes:  1440 + 5,
+			Expected: time.Date(2023, time.March, 27, 0, 5, 0, 0, berlin),
+		},
+		{
+			Name:     "nonexistent minute, shift forward",
+			Date:     springForward,
+			Minutes:  2*60 + 30,
+			Expected: time.Date(2023, time.March, 26, 3, 30, 0, 0, berlin),
+		},
+		{
+			Name:     "nonexistent minute, next valid",
+			Date:     springForward,
+			Minutes:  2*60 + 30,
+			Opts:     []timefn.WallClockOption{timefn.OnGap(timefn.GapNextValid)},
+			Expected: time.Date(2023, time.March, 26, 3, 0, 0, 0, berlin),
+		},
+		{
+			Name:    "nonexistent minute, reject",
+			Date:    springForward,
+			Minutes: 2*60 + 30,
+			Opts:    []timefn.WallClockOption{timefn.OnGap(timefn.GapReject)},
+			Err:     timefn.ErrNonexistentTime,
+		},
+		{
+			Name:     "ambiguous minute, earlier",
+			Date:     fallBack,
+			Minutes:  2*60 + 30,
+			Expected: time.Date(2023, time.October, 29, 0, 30, 0, 0, time.UTC),
+		},
+		{
+			Name:     "ambiguous minute, later",
+			Date:     fallBack,
+			Minutes:  2*60 + 30,
+			Opts:     []timefn.WallClockOption{timefn.OnAmbiguity(timefn.AmbiguityLater)},
+			Expected: time.Date(2023, time.October, 29, 1, 30, 0, 0, time.UTC),
+		},
+		{
+			Name:    "ambiguous minute, reject",
+			Date:    fallBack,
+			Minutes: 2*60 + 30,
+			Opts:    []timefn.WallClockOption{timefn.OnAmbiguity(timefn.AmbiguityReject)},
+			Err:     timefn.ErrAmbiguousTime,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := timefn.AtMinuteOfDay(test.Date, test.Minutes, berlin, test.Opts...)
+			if test.Err != nil {
+				assert.True(t, errors.Is(err, test.Err), "expected %v; got %v", test.Err, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, test.Expected.Equal(got), "expected %v; got %v", test.Expected, got)
+		})
+	}
+}
+
+func TestDaysInMonth(t *testing.T) {
+	tests := []struct {
+		Year     int
+		Month    time.Month
+		Expected int
+	}{
+		{Year: 2023, Month: time.January, Expected: 31},
+		{Year: 2023, Month: time.February, Expected: 28},
+		{Year: 2024, Month: time.February, Expected: 29},
+		{Year: 1900, Month: time.February, Expected: 28},
+		{Year: 2000, Month: time.February, Expected: 29},
+		{Year: 2023, Month: time.April, Expected: 30},
+		{Year: 2023, Month: time.December, Expected: 31},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, timefn.DaysInMonth(test.Year, test.Month), "%d-%02d", test.Year, int(test.Month))
+	}
+}
+
+func TestDaysInYear(t *testing.T) {
+	assert.Equal(t, 365, timefn.DaysInYear(2023))
+	assert.Equal(t, 366, timefn.DaysInYear(2024))
+	assert.Equal(t, 365, timefn.DaysInYear(1900))
+	assert.Equal(t, 366, timefn.DaysInYear(2000))
+}
+
+func TestIsLeapYear(t *testing.T) {
+	for year, expected := range map[int]bool{2023: false, 2024: true, 1900: false, 2000: true, 2100: false, -4: true} {
+		assert.Equal(t, expected, timefn.IsLeapYear(year), "%d", year)
+	}
+}
+
+func TestDiffInCalendarDays(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		a, b     time.Time
+		expected int
+	}{
+		{
+			name:     "across midnight",
+			a:        time.Date(2023, 3, 2, 1, 0, 0, 0, time.UTC),
+			b:        time.Date(2023, 3, 1, 23, 0, 0, 0, time.UTC),
+			expected: 1,
+		},
+		{
+			name:     "same day",
+			a:        time.Date(2023, 3, 1, 23, 0, 0, 0, time.UTC),
+			b:        time.Date(2023, 3, 1, 1, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "negative",
+			a:        time.Date(2023, 2, 27, 12, 0, 0, 0, time.UTC),
+			b:        time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC),
+			expected: -2,
+		},
+		{
+			name:     "across dst",
+			a:        time.Date(2023, 3, 27, 0, 0, 0, 0, berlin),
+			b:        time.Date(2023, 3, 25, 23, 59, 0, 0, berlin),
+			expected: 2,
+		},
+		{
+			name:     "in location of a",
+			a:        time.Date(2023, 3, 2, 0, 30, 0, 0, berlin),
+			b:        time.Date(2023, 3, 1, 23, 30, 0, 0, time.UTC),
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, timefn.DiffInCalendarDays(test.a, test.b), test.name)
+	}
+}
+
+func TestDiffInMonths(t *testing.T) {
+	assert.Equal(t, 1, timefn.DiffInMonths(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 31, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 0, timefn.DiffInMonths(time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 14, timefn.DiffInMonths(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, -11, timefn.DiffInMonths(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestDiffInYears(t *testing.T) {
+	assert.Equal(t, 1, timefn.DiffInYears(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 12, 31, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, 0, timefn.DiffInYears(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, -3, timefn.DiffInYears(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,77 @@
+package timefn
+
+import (
+	"context"
+	"time"
+)
+
+// Today returns the current day in loc as a [Period], from its first instant
+// up to the first instant of the next day. The current time is read from
+// [Now].
+func Today(loc *time.Location) Period {
+	return dayOf(nil, loc, 0)
+}
+
+// TodayContext is like [Today], but reads the current time from
+// [NowFromContext].
+func TodayContext(ctx context.Context, loc *time.Location) Period {
+	return dayOf(ClockFromContext(ctx), loc, 0)
+}
+
+// Yesterday returns the day before the current day in loc as a [Period], see
+// [Today].
+func Yesterday(loc *time.Location) Period {
+	return dayOf(nil, loc, -1)
+}
+
+// YesterdayContext is like [Yesterday], but reads the current time from
+// [NowFromContext].
+func YesterdayContext(ctx context.Context, loc *time.Location) Period {
+	return dayOf(ClockFromContext(ctx), loc, -1)
+}
+
+// Tomorrow returns the day after the current day in loc as a [Period], see
+// [Today].
+func Tomorrow(loc *time.Location) Period {
+	return dayOf(nil, loc, 1)
+}
+
+// TomorrowContext is like [Tomorrow], but reads the current time from
+// [NowFromContext].
+func TomorrowContext(ctx context.Context, loc *time.Location) Period {
+	return dayOf(ClockFromContext(ctx), loc, 1)
+}
+
+// StartOfToday returns the first instant of the current day in loc, which is
+// midnight unless midnight does not exist because of a DST transition. The
+// current time is read from clock, or from [Now] if clock is nil.
+func StartOfToday(clock Clock, loc *time.Location) time.Time {
+	return dayOf(clock, loc, 0).Start
+}
+
+// StartOfYesterday returns the first instant of the day before the current
+// day in loc, see [StartOfToday].
+func StartOfYesterday(clock Clock, loc *time.Location) time.Time {
+	return dayOf(clock, loc, -1).Start
+}
+
+// StartOfTomorrow returns the first instant of the day after the current day
+// in loc, see [StartOfToday]. It is also the end of the current day.
+func StartOfTomorrow(clock Clock, loc *time.Location) time.Time {
+	return dayOf(clock, loc, 1).Start
+}
+
+// TodayOn returns the current day in loc as a [Period] like [Today], but reads
+// the current time from clock, or from [Now] if clock is nil.
+func TodayOn(clock Clock, loc *time.Location) Period {
+	return dayOf(clock, loc, 0)
+}
+
+// dayOf returns the day that is offset days away from the current day in loc.
+func dayOf(clock Clock, loc *time.Location, offset int) Period {
+	now := Now
+	if clock != nil {
+		now = clock.Now
+	}
+	return UnitDay.Period(UnitDay.Add(now().In(loc), offset))
+}
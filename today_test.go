@@ -0,0 +1,73 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestToday(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	// 23:30 UTC on March 25 is 00:30 on March 26 in Berlin, the day on which
+	// DST starts.
+	defer freezeNow(time.Date(2023, time.March, 25, 23, 30, 0, 0, time.UTC))()
+
+	day := func(d int) time.Time { return time.Date(2023, time.March, d, 0, 0, 0, 0, berlin) }
+
+	tests := []struct {
+		name string
+		got  timefn.Period
+		want timefn.Period
+	}{
+		{name: "Today", got: timefn.Today(berlin), want: timefn.Period{Start: day(26), End: day(27)}},
+		{name: "Yesterday", got: timefn.Yesterday(berlin), want: timefn.Period{Start: day(25), End: day(26)}},
+		{name: "Tomorrow", got: timefn.Tomorrow(berlin), want: timefn.Period{Start: day(27), End: day(28)}},
+		{name: "Today in UTC", got: timefn.Today(time.UTC), want: timefn.Period{
+			Start: time.Date(2023, time.March, 25, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, time.March, 26, 0, 0, 0, 0, time.UTC),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Start.Equal(tt.want.Start) || !tt.got.End.Equal(tt.want.End) {
+				t.Errorf("%s() = %v; want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+
+	if got := timefn.Today(berlin).Duration(); got != 23*time.Hour {
+		t.Errorf("Today() lasts %v; want %v", got, 23*time.Hour)
+	}
+}
+
+func TestStartOfToday(t *testing.T) {
+	clock := timefn.NewFakeClock(time.Date(2023, time.June, 15, 14, 0, 0, 0, time.UTC))
+
+	if got, want := timefn.StartOfToday(clock, time.UTC), time.Date(2023, time.June, 15, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfToday() = %v; want %v", got, want)
+	}
+
+	if got, want := timefn.StartOfYesterday(clock, time.UTC), time.Date(2023, time.June, 14, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfYesterday() = %v; want %v", got, want)
+	}
+
+	if got, want := timefn.StartOfTomorrow(clock, time.UTC), time.Date(2023, time.June, 16, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfTomorrow() = %v; want %v", got, want)
+	}
+
+	clock.Advance(12 * time.Hour)
+	if got, want := timefn.TodayOn(clock, time.UTC).Start, time.Date(2023, time.June, 16, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("TodayOn() starts at %v; want %v", got, want)
+	}
+
+	defer freezeNow(time.Date(2020, time.January, 2, 3, 0, 0, 0, time.UTC))()
+	if got, want := timefn.StartOfToday(nil, time.UTC), time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfToday(nil) = %v; want %v", got, want)
+	}
+}
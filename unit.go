@@ -0,0 +1,230 @@
+package timefn
+
+import "time"
+
+// Unit is a unit of calendar time, such as a day or a month, used to bucket
+// and step through time. Units of a day and above follow the calendar in the
+// location of the given time, so a day is not always 24 hours long.
+type Unit int
+
+const (
+	// UnitSecond is a second.
+	UnitSecond Unit = iota + 1
+
+	// UnitMinute is a minute.
+	UnitMinute
+
+	// UnitHour is an hour.
+	UnitHour
+
+	// UnitDay is a calendar day, from midnight to midnight.
+	UnitDay
+
+	// UnitWeek is an ISO 8601 week, from Monday to Sunday.
+	UnitWeek
+
+	// UnitMonth is a calendar month.
+	UnitMonth
+
+	// UnitQuarter is a calendar quarter.
+	UnitQuarter
+
+	// UnitYear is a calendar year.
+	UnitYear
+)
+
+var unitNames = [...]string{
+	UnitSecond:  "second",
+	UnitMinute:  "minute",
+	UnitHour:    "hour",
+	UnitDay:     "day",
+	UnitWeek:    "week",
+	UnitMonth:   "month",
+	UnitQuarter: "quarter",
+	UnitYear:    "year",
+}
+
+// String returns the name of the unit, e.g. "month".
+func (u Unit) String() string {
+	if u < UnitSecond || u > UnitYear {
+		return "<unknown unit>"
+	}
+	return unitNames[u]
+}
+
+// Start returns the start of the unit that contains t. The location of t is
+// preserved. Units of a day and above start at midnight; if midnight does not
+// exist on that day because of a DST transition, they start at the first
+// instant of the day instead. Start returns t unchanged for unknown units.
+func (u Unit) Start(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	switch u {
+	case UnitSecond:
+		return StartOfSecond(t)
+	case UnitMinute:
+		return StartOfMinute(t)
+	case UnitHour:
+		return StartOfHour(t)
+	case UnitDay:
+	case UnitWeek:
+		d -= (int(t.Weekday()) + 6) % 7
+	case UnitMonth:
+		d = 1
+	case UnitQuarter:
+		m, d = m-(m-1)%3, 1
+	case UnitYear:
+		m, d = time.January, 1
+	default:
+		return t
+	}
+
+	return firstInstantOf(y, m, d, t.Location())
+}
+
+// firstInstantOf returns the first instant of the given date in loc, which is
+// midnight unless midnight falls into a DST gap.
+func firstInstantOf(year int, month time.Month, day int, loc *time.Location) time.Time {
+	start, _ := resolveWallClock(time.Date(year, month, day, 0, 0, 0, 0, time.UTC), loc, wallClockConfig{gap: GapNextValid})
+	return start
+}
+
+// Add returns t moved by n units. Seconds, minutes and hours are added as
+// elapsed time; larger units are added on the calendar using
+// [time.Time.AddDate], so they keep the wall-clock time of t.
+func (u Unit) Add(t time.Time, n int) time.Time {
+	switch u {
+	case UnitSecond, UnitMinute, UnitHour:
+		return t.Add(time.Duration(n) * u.approx())
+	case UnitDay:
+		return t.AddDate(0, 0, n)
+	case UnitWeek:
+		return t.AddDate(0, 0, 7*n)
+	case UnitMonth:
+		return t.AddDate(0, n, 0)
+	case UnitQuarter:
+		return t.AddDate(0, 3*n, 0)
+	case UnitYear:
+		return t.AddDate(n, 0, 0)
+	default:
+		return t
+	}
+}
+
+// Period returns the unit that contains t as a [Period]. If a unit starts at
+// a midnight that does not exist because of a DST transition, it starts at the
+// first instant of that day instead.
+func (u Unit) Period(t time.Time) Period {
+	start := u.Start(t)
+	if u < UnitDay {
+		return Period{Start: start, End: u.Add(start, 1)}
+	}
+
+	// Step from noon, which is not affected by DST transitions at midnight.
+	y, m, d := start.Date()
+	noon := time.Date(y, m, d, 12, 0, 0, 0, start.Location())
+	return Period{Start: start, End: u.Start(u.Add(noon, 1))}
+}
+
+// approx returns the nominal duration of the unit, assuming days of 24 hours,
+// months of 30 days and years of 365 days.
+func (u Unit) approx() time.Duration {
+	switch u {
+	case UnitSecond:
+		return time.Second
+	case UnitMinute:
+		return time.Minute
+	case UnitHour:
+		return time.Hour
+	case UnitDay:
+		return 24 * time.Hour
+	case UnitWeek:
+		return 7 * 24 * time.Hour
+	case UnitMonth:
+		return 30 * 24 * time.Hour
+	case UnitQuarter:
+		return 91 * 24 * time.Hour
+	case UnitYear:
+		return 365 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// InferUnit returns the largest unit that the period spans exactly, such as
+// the month from March 1 to April 1. Because the End of a unit is commonly
+// given as its last nanosecond, as returned by [EndOfMonth] and similar
+// functions, periods that end one nanosecond before the end of a unit are
+// accepted as well. Units are evaluated in the location of p.Start. InferUnit
+// returns false if the period does not span exactly one unit or is not valid.
+func InferUnit(p Period) (Unit, bool) {
+	if p.Validate() != nil {
+		return 0, false
+	}
+
+	end := p.End.In(p.Start.Location())
+	for u := UnitYear; u >= UnitSecond; u-- {
+		up := u.Period(p.Start)
+		if up.Start.Equal(p.Start) && (up.End.Equal(end) || up.End.Equal(end.Add(time.Nanosecond))) {
+			return u, true
+		}
+	}
+
+	return 0, false
+}
+
+// bucketSizes are the resolutions that [SuggestBucket] chooses from, from
+// smallest to largest. Units of a day and above vary in length, so longest is
+// the longest length that a bucket of the resolution can have.
+var bucketSizes = [...]struct {
+	unit    Unit
+	size    time.Duration
+	longest time.Duration
+}{
+	{UnitSecond, time.Second, time.Second},
+	{UnitSecond, 5 * time.Second, 5 * time.Second},
+	{UnitSecond, 10 * time.Second, 10 * time.Second},
+	{UnitSecond, 15 * time.Second, 15 * time.Second},
+	{UnitSecond, 30 * time.Second, 30 * time.Second},
+	{UnitMinute, time.Minute, time.Minute},
+	{UnitMinute, 5 * time.Minute, 5 * time.Minute},
+	{UnitMinute, 10 * time.Minute, 10 * time.Minute},
+	{UnitMinute, 15 * time.Minute, 15 * time.Minute},
+	{UnitMinute, 30 * time.Minute, 30 * time.Minute},
+	{UnitHour, time.Hour, time.Hour},
+	{UnitHour, 3 * time.Hour, 3 * time.Hour},
+	{UnitHour, 6 * time.Hour, 6 * time.Hour},
+	{UnitHour, 12 * time.Hour, 12 * time.Hour},
+	{UnitDay, UnitDay.approx(), 25 * time.Hour},
+	{UnitWeek, UnitWeek.approx(), 7*24*time.Hour + time.Hour},
+	{UnitMonth, UnitMonth.approx(), 31*24*time.Hour + time.Hour},
+	{UnitQuarter, UnitQuarter.approx(), 92*24*time.Hour + time.Hour},
+	{UnitYear, UnitYear.approx(), 366*24*time.Hour + time.Hour},
+}
+
+// SuggestBucket suggests a bucket size for rendering the period into roughly
+// targetBuckets buckets, e.g. for the x-axis of a chart. It returns the
+// smallest of a fixed set of sensible resolutions, such as 5 minutes, 6 hours
+// or 1 month, that does not result in more than targetBuckets buckets,
+// together with its unit. Calendar units are compared by their longest
+// possible length, so a year always fits into 12 monthly buckets. For units of
+// a day and above, the returned duration is the nominal length of the unit,
+// and buckets should be aligned using the [Unit] rather than the duration. If
+// the period is longer than targetBuckets years, years are suggested.
+// SuggestBucket returns zero values if the period is not valid or
+// targetBuckets is not positive.
+func SuggestBucket(p Period, targetBuckets int) (Unit, time.Duration) {
+	if p.Validate() != nil || targetBuckets <= 0 {
+		return 0, 0
+	}
+
+	want := p.Duration() / time.Duration(targetBuckets)
+	for _, b := range bucketSizes {
+		if b.longest >= want {
+			return b.unit, b.size
+		}
+	}
+
+	last := bucketSizes[len(bucketSizes)-1]
+	return last.unit, last.size
+}
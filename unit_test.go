@@ -0,0 +1,127 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestUnit_Period(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tm := time.Date(2023, time.March, 26, 14, 35, 12, 500, berlin)
+
+	tests := []struct {
+		unit      timefn.Unit
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{unit: timefn.UnitSecond, wantStart: time.Date(2023, time.March, 26, 14, 35, 12, 0, berlin), wantEnd: time.Date(2023, time.March, 26, 14, 35, 13, 0, berlin)},
+		{unit: timefn.UnitMinute, wantStart: time.Date(2023, time.March, 26, 14, 35, 0, 0, berlin), wantEnd: time.Date(2023, time.March, 26, 14, 36, 0, 0, berlin)},
+		{unit: timefn.UnitHour, wantStart: time.Date(2023, time.March, 26, 14, 0, 0, 0, berlin), wantEnd: time.Date(2023, time.March, 26, 15, 0, 0, 0, berlin)},
+		{unit: timefn.UnitDay, wantStart: time.Date(2023, time.March, 26, 0, 0, 0, 0, berlin), wantEnd: time.Date(2023, time.March, 27, 0, 0, 0, 0, berlin)},
+		{unit: timefn.UnitWeek, wantStart: time.Date(2023, time.March, 20, 0, 0, 0, 0, berlin), wantEnd: time.Date(2023, time.March, 27, 0, 0, 0, 0, berlin)},
+		{unit: timefn.UnitMonth, wantStart: time.Date(2023, time.March, 1, 0, 0, 0, 0, berlin), wantEnd: time.Date(2023, time.April, 1, 0, 0, 0, 0, berlin)},
+		{unit: timefn.UnitQuarter, wantStart: time.Date(2023, time.January, 1, 0, 0, 0, 0, berlin), wantEnd: time.Date(2023, time.April, 1, 0, 0, 0, 0, berlin)},
+		{unit: timefn.UnitYear, wantStart: time.Date(2023, time.January, 1, 0, 0, 0, 0, berlin), wantEnd: time.Date(2024, time.January, 1, 0, 0, 0, 0, berlin)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			p := tt.unit.Period(tm)
+			if !p.Start.Equal(tt.wantStart) || !p.End.Equal(tt.wantEnd) {
+				t.Errorf("Period() = %v, want %v -> %v", p, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+
+	// The DST transition shortens March 26 to 23 hours.
+	if d := timefn.UnitDay.Period(tm).Duration(); d != 23*time.Hour {
+		t.Errorf("expected day to last 23h; got %v", d)
+	}
+}
+
+func TestInferUnit(t *testing.T) {
+	// Havana switches to DST at midnight, so March 12, 2023 starts at 01:00.
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		p      timefn.Period
+		want   timefn.Unit
+		wantOK bool
+	}{
+		{name: "day", p: timefn.Period{Start: date(2023, 3, 1), End: date(2023, 3, 2)}, want: timefn.UnitDay, wantOK: true},
+		{name: "day ending at last nanosecond", p: timefn.Period{Start: date(2023, 3, 1), End: timefn.EndOfDay(date(2023, 3, 1))}, want: timefn.UnitDay, wantOK: true},
+		{name: "iso week", p: timefn.Period{Start: date(2023, 3, 6), End: date(2023, 3, 13)}, want: timefn.UnitWeek, wantOK: true},
+		{name: "sunday week", p: timefn.Period{Start: date(2023, 3, 5), End: date(2023, 3, 12)}},
+		{name: "month", p: timefn.Period{Start: date(2023, 2, 1), End: timefn.EndOfMonth(date(2023, 2, 1))}, want: timefn.UnitMonth, wantOK: true},
+		{name: "quarter", p: timefn.Period{Start: date(2023, 4, 1), End: date(2023, 7, 1)}, want: timefn.UnitQuarter, wantOK: true},
+		{name: "year", p: timefn.Period{Start: date(2023, 1, 1), End: date(2024, 1, 1)}, want: timefn.UnitYear, wantOK: true},
+		{name: "hour", p: timefn.Period{Start: date(2023, 1, 1), End: date(2023, 1, 1).Add(time.Hour)}, want: timefn.UnitHour, wantOK: true},
+		{name: "two days", p: timefn.Period{Start: date(2023, 3, 1), End: date(2023, 3, 3)}},
+		{name: "unaligned day", p: timefn.Period{Start: date(2023, 3, 1).Add(time.Hour), End: date(2023, 3, 2).Add(time.Hour)}},
+		{
+			name:   "dst day starting at 01:00",
+			p:      timefn.Period{Start: time.Date(2023, 3, 12, 1, 0, 0, 0, havana), End: time.Date(2023, 3, 13, 0, 0, 0, 0, havana)},
+			want:   timefn.UnitDay,
+			wantOK: true,
+		},
+		{name: "open", p: timefn.Period{Start: date(2023, 3, 1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := timefn.InferUnit(tt.p)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("InferUnit() = %v, %v; want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSuggestBucket(t *testing.T) {
+	start := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		duration time.Duration
+		target   int
+		wantUnit timefn.Unit
+		wantSize time.Duration
+	}{
+		{name: "minute into seconds", duration: time.Minute, target: 60, wantUnit: timefn.UnitSecond, wantSize: time.Second},
+		{name: "hour into minutes", duration: time.Hour, target: 12, wantUnit: timefn.UnitMinute, wantSize: 5 * time.Minute},
+		{name: "rounds up to next size", duration: time.Hour, target: 10, wantUnit: timefn.UnitMinute, wantSize: 10 * time.Minute},
+		{name: "day into hours", duration: 24 * time.Hour, target: 24, wantUnit: timefn.UnitHour, wantSize: time.Hour},
+		{name: "week into days", duration: 7 * 24 * time.Hour, target: 7, wantUnit: timefn.UnitDay, wantSize: 24 * time.Hour},
+		{name: "quarter into weeks", duration: 91 * 24 * time.Hour, target: 13, wantUnit: timefn.UnitWeek, wantSize: 7 * 24 * time.Hour},
+		{name: "year into months", duration: 365 * 24 * time.Hour, target: 12, wantUnit: timefn.UnitMonth, wantSize: 30 * 24 * time.Hour},
+		{name: "decades into years", duration: 100 * 365 * 24 * time.Hour, target: 10, wantUnit: timefn.UnitYear, wantSize: 365 * 24 * time.Hour},
+		{name: "invalid target", duration: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unit, size := timefn.SuggestBucket(timefn.Period{Start: start, End: start.Add(tt.duration)}, tt.target)
+			if unit != tt.wantUnit || size != tt.wantSize {
+				t.Errorf("SuggestBucket() = %v, %v; want %v, %v", unit, size, tt.wantUnit, tt.wantSize)
+			}
+		})
+	}
+
+	if unit, size := timefn.SuggestBucket(timefn.Period{Start: start}, 10); unit != 0 || size != 0 {
+		t.Errorf("SuggestBucket() of an open period = %v, %v; want zero values", unit, size)
+	}
+}
@@ -0,0 +1,97 @@
+package timefn
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrPeriodNotStarted is the cause of the cancellation of a context returned
+// by [Period.Context] if the period has not started yet. It is returned by
+// [context.Cause].
+var ErrPeriodNotStarted = errors.New("period has not started")
+
+// SleepUntil blocks until t or until ctx is canceled, whichever happens first.
+// It returns nil if t was reached, and the error of ctx otherwise. The time
+// left until t is computed using [NowFromContext], and SleepUntil returns
+// immediately if t is not in the future. Because timers follow the system
+// clock, a replaced [Now] or a time carried by ctx only shifts the start of the
+// wait, not its progress.
+func SleepUntil(ctx context.Context, t time.Time) error {
+	d := t.Sub(NowFromContext(ctx))
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WaitForStart blocks until the period starts or ctx is canceled, see
+// [SleepUntil]. It returns immediately if the period has an open start.
+func WaitForStart(ctx context.Context, p Period) error {
+	if p.OpenStart() {
+		return nil
+	}
+	return SleepUntil(ctx, p.Start)
+}
+
+// WaitForEnd blocks until the period ends or ctx is canceled, see
+// [SleepUntil]. If the period has an open end, it never ends, so WaitForEnd
+// blocks until ctx is canceled.
+func WaitForEnd(ctx context.Context, p Period) error {
+	if p.OpenEnd() {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return SleepUntil(ctx, p.End)
+}
+
+// Context returns a copy of parent that is only valid within the period. If
+// the period has not started yet, the returned context is already canceled and
+// [context.Cause] returns [ErrPeriodNotStarted]. Otherwise, its deadline is the
+// end of the period, so it is canceled when the period ends, or immediately if
+// the period is already over. Periods with an open end only inherit the
+// deadline of parent. Whether the period has started and the time left until
+// its end are computed using [NowFromContext] of parent. This makes it easy to
+// restrict work to a maintenance window:
+//
+//	ctx, cancel := window.Context(ctx)
+//	defer cancel()
+//	if err := ctx.Err(); err != nil {
+//		return context.Cause(ctx)
+//	}
+//
+// Canceling the returned context releases the resources associated with it,
+// so code should call cancel as soon as the work in the period is done.
+func (p Period) Context(parent context.Context) (context.Context, context.CancelFunc) {
+	now := NowFromContext(parent)
+	if !p.OpenStart() && p.Start.After(now) {
+		ctx, cancel := context.WithCancelCause(parent)
+		cancel(ErrPeriodNotStarted)
+		return ctx, func() { cancel(context.Canceled) }
+	}
+
+	if p.OpenEnd() {
+		return context.WithCancel(parent)
+	}
+
+	return context.WithTimeout(parent, p.End.Sub(now))
+}
+
+// Deadline returns the end of the period, which is the deadline of the
+// context returned by [Period.Context]. ok is false if the period has an open
+// end.
+func (p Period) Deadline() (deadline time.Time, ok bool) {
+	if p.OpenEnd() {
+		return time.Time{}, false
+	}
+	return p.End, true
+}
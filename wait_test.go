@@ -0,0 +1,147 @@
+package timefn_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestSleepUntil(t *testing.T) {
+	start := time.Now()
+	if err := timefn.SleepUntil(context.Background(), start.Add(20*time.Millisecond)); err != nil {
+		t.Fatalf("SleepUntil() failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("SleepUntil() returned after %v; want at least %v", elapsed, 20*time.Millisecond)
+	}
+
+	if err := timefn.SleepUntil(context.Background(), start.Add(-time.Hour)); err != nil {
+		t.Errorf("SleepUntil() with past time failed: %v", err)
+	}
+}
+
+func TestSleepUntil_canceled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := timefn.SleepUntil(ctx, time.Now().Add(time.Hour))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("SleepUntil() = %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWaitForStartAndEnd(t *testing.T) {
+	now := time.Now()
+	p := timefn.Period{Start: now.Add(10 * time.Millisecond), End: now.Add(20 * time.Millisecond)}
+
+	if err := timefn.WaitForStart(context.Background(), p); err != nil {
+		t.Fatalf("WaitForStart() failed: %v", err)
+	}
+	if time.Now().Before(p.Start) {
+		t.Errorf("WaitForStart() returned before the start of the period")
+	}
+
+	if err := timefn.WaitForEnd(context.Background(), p); err != nil {
+		t.Fatalf("WaitForEnd() failed: %v", err)
+	}
+	if time.Now().Before(p.End) {
+		t.Errorf("WaitForEnd() returned before the end of the period")
+	}
+
+	if err := timefn.WaitForStart(context.Background(), timefn.Period{End: now}); err != nil {
+		t.Errorf("WaitForStart() with open start failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := timefn.WaitForEnd(ctx, timefn.Period{Start: now}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForEnd() with open end = %v; want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestPeriod_Context(t *testing.T) {
+	now := time.Now()
+
+	t.Run("not started", func(t *testing.T) {
+		p := timefn.Period{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}
+		ctx, cancel := p.Context(context.Background())
+		defer cancel()
+
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			t.Errorf("Err() = %v; want %v", ctx.Err(), context.Canceled)
+		}
+		if cause := context.Cause(ctx); !errors.Is(cause, timefn.ErrPeriodNotStarted) {
+			t.Errorf("Cause() = %v; want %v", cause, timefn.ErrPeriodNotStarted)
+		}
+	})
+
+	t.Run("running", func(t *testing.T) {
+		p := timefn.Period{Start: now.Add(-time.Hour), End: now.Add(20 * time.Millisecond)}
+		ctx, cancel := p.Context(context.Background())
+		defer cancel()
+
+		if err := ctx.Err(); err != nil {
+			t.Fatalf("Err() = %v; want <nil>", err)
+		}
+		deadline, ok := ctx.Deadline()
+		if !ok || deadline.Sub(p.End).Abs() > 10*time.Millisecond {
+			t.Errorf("Deadline() = %v, %v; want about %v", deadline, ok, p.End)
+		}
+
+		<-ctx.Done()
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			t.Errorf("Err() = %v; want %v", ctx.Err(), context.DeadlineExceeded)
+		}
+	})
+
+	t.Run("over", func(t *testing.T) {
+		p := timefn.Period{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)}
+		ctx, cancel := p.Context(context.Background())
+		defer cancel()
+
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			t.Errorf("Err() = %v; want %v", ctx.Err(), context.DeadlineExceeded)
+		}
+	})
+
+	t.Run("open end", func(t *testing.T) {
+		ctx, cancel := timefn.Period{Start: now.Add(-time.Hour)}.Context(context.Background())
+		if _, ok := ctx.Deadline(); ok {
+			t.Errorf("Deadline() reported a deadline for an open period")
+		}
+		cancel()
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			t.Errorf("Err() = %v; want %v", ctx.Err(), context.Canceled)
+		}
+	})
+}
+
+func TestPeriod_Context_frozenNow(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	defer timefn.UseClock(timefn.NewFakeClock(start))()
+
+	p := timefn.Period{Start: start, End: start.Add(time.Hour)}
+	ctx, cancel := p.Context(context.Background())
+	defer cancel()
+
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("Err() = %v; want <nil>", err)
+	}
+	deadline, _ := ctx.Deadline()
+	if remaining := time.Until(deadline); remaining < 59*time.Minute {
+		t.Errorf("time until deadline = %v; want about %v", remaining, time.Hour)
+	}
+}
+
+func TestPeriod_Deadline(t *testing.T) {
+	end := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	if got, ok := (timefn.Period{End: end}).Deadline(); !ok || !got.Equal(end) {
+		t.Errorf("Deadline() = %v, %v; want %v, true", got, ok, end)
+	}
+	if _, ok := (timefn.Period{Start: end}).Deadline(); ok {
+		t.Errorf("Deadline() of an open period reported a deadline")
+	}
+}
@@ -0,0 +1,199 @@
+package timefn
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNonexistentTime is returned when a wall time does not exist in a
+	// location because it falls into a gap, e.g. when clocks are moved forward
+	// for daylight saving time, and the [GapPolicy] is [GapReject].
+	ErrNonexistentTime = errors.New("nonexistent wall time")
+
+	// ErrAmbiguousTime is returned when a wall time occurs twice in a location,
+	// e.g. when clocks are moved back at the end of daylight saving time, and
+	// the [AmbiguityPolicy] is [AmbiguityReject].
+	ErrAmbiguousTime = errors.New("ambiguous wall time")
+)
+
+// GapPolicy defines how a wall time that does not exist in a location is
+// resolved to an instant. Such wall times fall into a gap that occurs when
+// clocks are moved forward, e.g. 02:30 on the day daylight saving time starts
+// in most European zones.
+type GapPolicy int
+
+const (
+	// GapShiftForward shifts the wall time forward by the length of the gap,
+	// e.g. 02:30 becomes 03:30 if clocks are moved forward from 02:00 to 03:00.
+	GapShiftForward GapPolicy = iota
+
+	// GapNextValid resolves the wall time to the first instant after the gap,
+	// e.g. 02:30 becomes 03:00 if clocks are moved forward from 02:00 to 03:00.
+	GapNextValid
+
+	// GapReject rejects the wall time with an [ErrNonexistentTime] error.
+	GapReject
+)
+
+// AmbiguityPolicy defines how a wall time that occurs twice in a location is
+// resolved to an instant. Such wall times occur when clocks are moved back,
+// e.g. 02:30 on the day daylight saving time ends in most European zones.
+type AmbiguityPolicy int
+
+const (
+	// AmbiguityEarlier resolves the wall time to the earlier of both instants.
+	AmbiguityEarlier AmbiguityPolicy = iota
+
+	// AmbiguityLater resolves the wall time to the later of both instants.
+	AmbiguityLater
+
+	// AmbiguityReject rejects the wall time with an [ErrAmbiguousTime] error.
+	AmbiguityReject
+)
+
+// WallClockOption is an option for functions that resolve wall times to
+// instants, such as [AtMinuteOfDay].
+type WallClockOption func(*wallClockConfig)
+
+type wallClockConfig struct {
+	gap       GapPolicy
+	ambiguity AmbiguityPolicy
+}
+
+// OnGap returns a [WallClockOption] that sets the [GapPolicy] for wall times
+// that do not exist. The default policy is [GapShiftForward].
+func OnGap(policy GapPolicy) WallClockOption {
+	return func(cfg *wallClockConfig) {
+		cfg.gap = policy
+	}
+}
+
+// OnAmbiguity returns a [WallClockOption] that sets the [AmbiguityPolicy] for
+// wall times that occur twice. The default policy is [AmbiguityEarlier].
+func OnAmbiguity(policy AmbiguityPolicy) WallClockOption {
+	return func(cfg *wallClockConfig) {
+		cfg.ambiguity = policy
+	}
+}
+
+func newWallClockConfig(opts []WallClockOption) wallClockConfig {
+	var cfg wallClockConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// wallClockInstants returns the instants at which the wall clock in loc shows
+// the date and time of wall, which must be in UTC. The result is empty if the
+// wall time falls into a gap (e.g. when clocks are moved forward for daylight
+// saving time), contains two instants if the wall time is ambiguous (e.g. when
+// clocks are moved back) and contains exactly one instant otherwise. It also
+// returns the zone offset (in seconds east of UTC) in effect before wall.
+func wallClockInstants(wall time.Time, loc *time.Location) ([]time.Time, int) {
+	var out []time.Time
+	var before int
+	seen := make(map[int]bool, 2)
+
+	for i, probe := range []time.Time{wall.Add(-24 * time.Hour), wall, wall.Add(24 * time.Hour)} {
+		_, offset := probe.In(loc).Zone()
+		if i == 0 {
+			before = offset
+		}
+
+		if seen[offset] {
+			continue
+		}
+		seen[offset] = true
+
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if !wallClock(candidate).Equal(wall) {
+			continue
+		}
+
+		if len(out) > 0 && candidate.Before(out[0]) {
+			out = append([]time.Time{candidate}, out...)
+		} else {
+			out = append(out, candidate)
+		}
+	}
+
+	return out, before
+}
+
+// resolveWallClock returns the instant at which the wall clock in loc shows the
+// date and time of wall, which must be in UTC. Wall times that fall into a gap
+// or that are ambiguous are resolved using the policies of cfg. Unlike
+// [time.Date], the result does not depend on the direction of the transition
+// or the time zone.
+func resolveWallClock(wall time.Time, loc *time.Location, cfg wallClockConfig) (time.Time, error) {
+	instants, before := wallClockInstants(wall, loc)
+
+	switch len(instants) {
+	case 0:
+		shifted := wall.Add(-time.Duration(before) * time.Second).In(loc)
+		switch cfg.gap {
+		case GapNextValid:
+			start, _ := shifted.ZoneBounds()
+			return start.In(loc), nil
+		case GapReject:
+			return time.Time{}, fmt.Errorf("%w: %s does not exist in %s", ErrNonexistentTime, wall.Format(wallClockLayout), loc)
+		default:
+			return shifted, nil
+		}
+	case 1:
+		return instants[0], nil
+	default:
+		switch cfg.ambiguity {
+		case AmbiguityLater:
+			return instants[len(instants)-1], nil
+		case AmbiguityReject:
+			return time.Time{}, fmt.Errorf(
+				"%w: %s occurs at %s and %s in %s",
+				ErrAmbiguousTime,
+				wall.Format(wallClockLayout),
+				instants[0].Format(time.RFC3339),
+				instants[len(instants)-1].Format(time.RFC3339),
+				loc,
+			)
+		default:
+			return instants[0], nil
+		}
+	}
+}
+
+const wallClockLayout = "2006-01-02 15:04:05"
+
+// wallClock returns the date and time shown by the wall clock of t as a time
+// in UTC.
+func wallClock(t time.Time) time.Time {
+	y, m, d := t.Date()
+	h, min, s := t.Clock()
+	return time.Date(y, m, d, h, min, s, t.Nanosecond(), time.UTC)
+}
+
+// dstFragileWindow is the distance to a zone transition within which
+// [IsDSTFragile] reports a time as fragile.
+const dstFragileWindow = time.Hour
+
+// IsDSTFragile reports whether t lies within an hour of a zone transition in
+// its location, such as the start or end of daylight saving time. Wall-clock
+// schedules close to a transition, like a job at 02:30 local time, may run
+// twice, be skipped or run at an unexpected instant, so stored future times
+// for which IsDSTFragile returns true deserve a warning. Times in locations
+// without transitions, like UTC, are never fragile.
+func IsDSTFragile(t time.Time) bool {
+	start, end := t.ZoneBounds()
+	if !start.IsZero() && t.Sub(start) < dstFragileWindow {
+		return true
+	}
+	return !end.IsZero() && end.Sub(t) <= dstFragileWindow
+}
+
+// DSTFragile reports whether the start or end of the period is fragile, as
+// defined by [IsDSTFragile]. Open boundaries are never fragile.
+func (p Period) DSTFragile() bool {
+	return (!p.Start.IsZero() && IsDSTFragile(p.Start)) || (!p.End.IsZero() && IsDSTFragile(p.End))
+}
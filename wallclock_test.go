@@ -0,0 +1,69 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestIsDSTFragile(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	// Berlin switches to DST at 2023-03-26 01:00 UTC and back at 2023-10-29
+	// 01:00 UTC.
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{name: "utc", t: time.Date(2023, time.March, 26, 1, 0, 0, 0, time.UTC), want: false},
+		{name: "far from transition", t: time.Date(2023, time.March, 26, 12, 0, 0, 0, berlin), want: false},
+		{name: "right before spring forward", t: time.Date(2023, time.March, 26, 1, 30, 0, 0, berlin), want: true},
+		{name: "one hour before spring forward", t: time.Date(2023, time.March, 26, 1, 0, 0, 0, berlin), want: true},
+		{name: "more than an hour before spring forward", t: time.Date(2023, time.March, 26, 0, 59, 0, 0, berlin), want: false},
+		{name: "right after spring forward", t: time.Date(2023, time.March, 26, 3, 30, 0, 0, berlin), want: true},
+		{name: "one hour after spring forward", t: time.Date(2023, time.March, 26, 4, 0, 0, 0, berlin), want: false},
+		{name: "in fall back overlap", t: time.Date(2023, time.October, 29, 2, 30, 0, 0, berlin), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timefn.IsDSTFragile(tt.t); got != tt.want {
+				t.Errorf("IsDSTFragile(%v) = %v; want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_DSTFragile(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	safe := time.Date(2023, time.March, 25, 12, 0, 0, 0, berlin)
+	fragile := time.Date(2023, time.March, 26, 3, 30, 0, 0, berlin)
+
+	tests := []struct {
+		name string
+		p    timefn.Period
+		want bool
+	}{
+		{name: "safe", p: timefn.Period{Start: safe, End: safe.Add(time.Hour)}, want: false},
+		{name: "fragile start", p: timefn.Period{Start: fragile, End: fragile.Add(12 * time.Hour)}, want: true},
+		{name: "fragile end", p: timefn.Period{Start: safe, End: fragile}, want: true},
+		{name: "open end", p: timefn.Period{Start: safe}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.DSTFragile(); got != tt.want {
+				t.Errorf("DSTFragile() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
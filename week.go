@@ -0,0 +1,106 @@
+package timefn
+
+import (
+	"fmt"
+	"time"
+)
+
+// WeekSystem is a convention for numbering the weeks of a year. It defines the
+// day on which weeks start and which week counts as the first week of a year.
+// The zero value is [WeekISO].
+type WeekSystem int
+
+const (
+	// WeekISO is the ISO 8601 week numbering. Weeks start on Monday and week 1
+	// is the week that contains the first Thursday of the year.
+	WeekISO WeekSystem = iota
+
+	// WeekUS is the week numbering used in the United States. Weeks start on
+	// Sunday and week 1 is the week that contains January 1.
+	WeekUS
+
+	// WeekMiddleEastern is the week numbering used in much of the Middle East.
+	// Weeks start on Saturday and week 1 is the week that contains January 1.
+	WeekMiddleEastern
+)
+
+var weekSystemNames = [...]string{
+	WeekISO:           "ISO",
+	WeekUS:            "US",
+	WeekMiddleEastern: "Middle Eastern",
+}
+
+// String returns the name of the week system, e.g. "ISO".
+func (ws WeekSystem) String() string {
+	if ws < WeekISO || ws > WeekMiddleEastern {
+		return "<unknown week system>"
+	}
+	return weekSystemNames[ws]
+}
+
+// FirstDay returns the weekday on which weeks start in the week system.
+func (ws WeekSystem) FirstDay() time.Weekday {
+	switch ws {
+	case WeekUS:
+		return time.Sunday
+	case WeekMiddleEastern:
+		return time.Saturday
+	default:
+		return time.Monday
+	}
+}
+
+// minDaysInFirstWeek returns the number of days of the new year that the first
+// week of a year must contain.
+func (ws WeekSystem) minDaysInFirstWeek() int {
+	if ws == WeekUS || ws == WeekMiddleEastern {
+		return 1
+	}
+	return 4
+}
+
+// StartOfWeek returns midnight at the start of the week of t in the week
+// system. The location of t is preserved.
+func (ws WeekSystem) StartOfWeek(t time.Time) time.Time {
+	return StartOfWeekOn(t, ws.FirstDay())
+}
+
+// EndOfWeek returns the last nanosecond of the week of t in the week system.
+// The location of t is preserved.
+func (ws WeekSystem) EndOfWeek(t time.Time) time.Time {
+	return EndOfWeekOn(t, ws.FirstDay())
+}
+
+// WeekOfYear returns the week-numbering year and the week number of t in the
+// week system. The week-numbering year can differ from the calendar year of t
+// for days at the very start or end of a year.
+func (ws WeekSystem) WeekOfYear(t time.Time) (year, week int) {
+	sy, sm, sd := ws.StartOfWeek(t).Date()
+	start := time.Date(sy, sm, sd, 0, 0, 0, 0, time.UTC)
+
+	// The week belongs to the year that contains its decisive day, e.g. the
+	// Thursday of an ISO week.
+	year = start.AddDate(0, 0, 7-ws.minDaysInFirstWeek()).Year()
+
+	firstWeek := ws.firstWeekStart(year)
+	week = int(start.Sub(firstWeek)/(7*24*time.Hour)) + 1
+	return year, week
+}
+
+// firstWeekStart returns the start of week 1 of the given week-numbering year
+// as a UTC date.
+func (ws WeekSystem) firstWeekStart(year int) time.Time {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	start := ws.StartOfWeek(jan1)
+	if start.AddDate(0, 0, 7-ws.minDaysInFirstWeek()).Year() < year {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start
+}
+
+// FormatWeek returns the week of t in the week system in the form "2006-W01",
+// using the week-numbering year.
+func (ws WeekSystem) FormatWeek(t time.Time) string {
+	year, week := ws.WeekOfYear(t)
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
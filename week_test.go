@@ -0,0 +1,134 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestWeekSystem_WeekOfYear(t *testing.T) {
+	tests := []struct {
+		name      string
+		system    timefn.WeekSystem
+		t         time.Time
+		wantYear  int
+		wantWeek  int
+		wantLabel string
+	}{
+		{
+			name:      "ISO, mid year",
+			system:    timefn.WeekISO,
+			t:         time.Date(2023, time.July, 19, 0, 0, 0, 0, time.UTC),
+			wantYear:  2023,
+			wantWeek:  29,
+			wantLabel: "2023-W29",
+		},
+		{
+			name:      "ISO, January 1 in last week of previous year",
+			system:    timefn.WeekISO,
+			t:         time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantYear:  2022,
+			wantWeek:  52,
+			wantLabel: "2022-W52",
+		},
+		{
+			name:      "ISO, December 31 in first week of next year",
+			system:    timefn.WeekISO,
+			t:         time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC),
+			wantYear:  2025,
+			wantWeek:  1,
+			wantLabel: "2025-W01",
+		},
+		{
+			name:      "US, January 1",
+			system:    timefn.WeekUS,
+			t:         time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantYear:  2023,
+			wantWeek:  1,
+			wantLabel: "2023-W01",
+		},
+		{
+			name:      "US, mid year",
+			system:    timefn.WeekUS,
+			t:         time.Date(2023, time.July, 19, 0, 0, 0, 0, time.UTC),
+			wantYear:  2023,
+			wantWeek:  29,
+			wantLabel: "2023-W29",
+		},
+		{
+			name:      "US, December 31 in week containing January 1",
+			system:    timefn.WeekUS,
+			t:         time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC),
+			wantYear:  2024,
+			wantWeek:  1,
+			wantLabel: "2024-W01",
+		},
+		{
+			name:      "Middle Eastern, Saturday starts week 2",
+			system:    timefn.WeekMiddleEastern,
+			t:         time.Date(2023, time.January, 7, 0, 0, 0, 0, time.UTC),
+			wantYear:  2023,
+			wantWeek:  2,
+			wantLabel: "2023-W02",
+		},
+		{
+			name:      "Middle Eastern, Friday ends week 1",
+			system:    timefn.WeekMiddleEastern,
+			t:         time.Date(2023, time.January, 6, 0, 0, 0, 0, time.UTC),
+			wantYear:  2023,
+			wantWeek:  1,
+			wantLabel: "2023-W01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, week := tt.system.WeekOfYear(tt.t)
+			if year != tt.wantYear || week != tt.wantWeek {
+				t.Errorf("WeekOfYear() = %d, %d, want %d, %d", year, week, tt.wantYear, tt.wantWeek)
+			}
+
+			if label := tt.system.FormatWeek(tt.t); label != tt.wantLabel {
+				t.Errorf("FormatWeek() = %q, want %q", label, tt.wantLabel)
+			}
+		})
+	}
+}
+
+func TestWeekSystem_WeekOfYear_matchesISOWeek(t *testing.T) {
+	day := time.Date(1999, time.December, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 20000; i++ {
+		d := day.AddDate(0, 0, i)
+		wantYear, wantWeek := d.ISOWeek()
+		if year, week := timefn.WeekISO.WeekOfYear(d); year != wantYear || week != wantWeek {
+			t.Fatalf("WeekOfYear(%v) = %d, %d, want %d, %d", d, year, week, wantYear, wantWeek)
+		}
+	}
+}
+
+func TestWeekSystem_StartOfWeek(t *testing.T) {
+	tm := time.Date(2023, time.July, 19, 15, 30, 0, 0, time.UTC) // Wednesday
+
+	tests := []struct {
+		system    timefn.WeekSystem
+		wantStart time.Time
+	}{
+		{system: timefn.WeekISO, wantStart: timefn.StartOfISOWeek(tm)},
+		{system: timefn.WeekUS, wantStart: timefn.StartOfWeek(tm)},
+		{system: timefn.WeekMiddleEastern, wantStart: time.Date(2023, time.July, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.system.String(), func(t *testing.T) {
+			if got := tt.system.StartOfWeek(tm); !got.Equal(tt.wantStart) {
+				t.Errorf("StartOfWeek() = %v, want %v", got, tt.wantStart)
+			}
+
+			wantEnd := tt.wantStart.AddDate(0, 0, 7).Add(-time.Nanosecond)
+			if got := tt.system.EndOfWeek(tm); !got.Equal(wantEnd) {
+				t.Errorf("EndOfWeek() = %v, want %v", got, wantEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package timefn
+
+import "time"
+
+// Calendar carries the week start and weekend days of a locale or tenant, so
+// that week boundaries and weekend checks do not need hard-coded weekday
+// constants. Use [NewCalendar] to create one; the zero value has weeks that
+// start on Sunday, like [StartOfWeek], and no weekend days. A Calendar is a
+// small value that can be copied freely.
+type Calendar struct {
+	weekStart time.Weekday
+	weekend   [7]bool
+}
+
+// CalendarOption is an option for [NewCalendar].
+type CalendarOption func(*Calendar)
+
+// WeekStartsOn returns a [CalendarOption] that sets the first day of the week,
+// replacing the default of Sunday.
+func WeekStartsOn(d time.Weekday) CalendarOption {
+	return func(c *Calendar) {
+		c.weekStart = d % 7
+	}
+}
+
+// WeekendOn returns a [CalendarOption] that sets the weekend days, replacing
+// the default of Saturday and Sunday. Calling it without any days results in a
+// calendar without weekend days.
+func WeekendOn(days ...time.Weekday) CalendarOption {
+	return func(c *Calendar) {
+		c.weekend = [7]bool{}
+		for _, d := range days {
+			c.weekend[d%7] = true
+		}
+	}
+}
+
+// NewCalendar returns a [Calendar] with weeks that start on Sunday and a
+// weekend of Saturday and Sunday, configured by the given options.
+func NewCalendar(opts ...CalendarOption) Calendar {
+	var c Calendar
+	c.weekend[time.Saturday] = true
+	c.weekend[time.Sunday] = true
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// WeekStart returns the first day of the week.
+func (c Calendar) WeekStart() time.Weekday {
+	return c.weekStart
+}
+
+// Weekend returns the weekend days, ordered from Sunday to Saturday.
+func (c Calendar) Weekend() []time.Weekday {
+	var out []time.Weekday
+	for d, weekend := range c.weekend {
+		if weekend {
+			out = append(out, time.Weekday(d))
+		}
+	}
+	return out
+}
+
+// StartOfWeek returns midnight at the start of the week of t. The location of
+// t is preserved.
+func (c Calendar) StartOfWeek(t time.Time) time.Time {
+	return StartOfWeekOn(t, c.weekStart)
+}
+
+// EndOfWeek returns the last instant of the week of t. The location of t is
+// preserved.
+func (c Calendar) EndOfWeek(t time.Time) time.Time {
+	return EndOfWeekOn(t, c.weekStart)
+}
+
+// IsWeekend reports whether the day of t is a weekend day.
+func (c Calendar) IsWeekend(t time.Time) bool {
+	return c.weekend[t.Weekday()]
+}
+
+// IsWeekday reports whether the day of t is not a weekend day.
+func (c Calendar) IsWeekday(t time.Time) bool {
+	return !c.IsWeekend(t)
+}
+
+// BusinessCalendar returns a [BusinessCalendar] with the weekend days of the
+// calendar, configured by the given options.
+func (c Calendar) BusinessCalendar(opts ...BusinessCalendarOption) *BusinessCalendar {
+	return NewBusinessCalendar(append([]BusinessCalendarOption{WithWeekend(c.Weekend()...)}, opts...)...)
+}
+
+// IsWeekend reports whether the day of t is a weekend day. The weekend is
+// Saturday and Sunday unless configured otherwise by the given options, e.g.
+//
+//	timefn.IsWeekend(t, timefn.WeekendOn(time.Friday, time.Saturday))
+//
+// Use a [Calendar] to carry the configuration around instead.
+func IsWeekend(t time.Time, opts ...CalendarOption) bool {
+	return NewCalendar(opts...).IsWeekend(t)
+}
+
+// IsWeekday reports whether the day of t is not a weekend day, as defined by
+// [IsWeekend].
+func IsWeekday(t time.Time, opts ...CalendarOption) bool {
+	return !IsWeekend(t, opts...)
+}
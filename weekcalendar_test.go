@@ -0,0 +1,111 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCalendar(t *testing.T) {
+	c := timefn.NewCalendar()
+	assert.Equal(t, time.Sunday, c.WeekStart())
+	assert.Equal(t, []time.Weekday{time.Sunday, time.Saturday}, c.Weekend())
+
+	c = timefn.NewCalendar(timefn.WeekStartsOn(time.Saturday), timefn.WeekendOn(time.Friday, time.Saturday))
+	assert.Equal(t, time.Saturday, c.WeekStart())
+	assert.Equal(t, []time.Weekday{time.Friday, time.Saturday}, c.Weekend())
+
+	assert.Empty(t, timefn.NewCalendar(timefn.WeekendOn()).Weekend())
+}
+
+func TestCalendar_Week(t *testing.T) {
+	// Wednesday, March 29, 2023
+	now := time.Date(2023, time.March, 29, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		calendar  timefn.Calendar
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "default",
+			calendar:  timefn.NewCalendar(),
+			wantStart: timefn.StartOfWeek(now),
+			wantEnd:   timefn.EndOfWeek(now),
+		},
+		{
+			name:      "monday",
+			calendar:  timefn.NewCalendar(timefn.WeekStartsOn(time.Monday)),
+			wantStart: timefn.StartOfISOWeek(now),
+			wantEnd:   timefn.EndOfISOWeek(now),
+		},
+		{
+			name:      "saturday",
+			calendar:  timefn.NewCalendar(timefn.WeekStartsOn(time.Saturday)),
+			wantStart: time.Date(2023, time.March, 25, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantStart, tt.calendar.StartOfWeek(now))
+			assert.Equal(t, tt.wantEnd, tt.calendar.EndOfWeek(now))
+		})
+	}
+}
+
+func TestStartOfWeek_options(t *testing.T) {
+	// Wednesday, March 29, 2023
+	now := time.Date(2023, time.March, 29, 15, 0, 0, 0, time.UTC)
+	saturday := timefn.WeekStartsOn(time.Saturday)
+
+	assert.Equal(t, time.Date(2023, time.March, 25, 0, 0, 0, 0, time.UTC), timefn.StartOfWeek(now, saturday))
+	assert.Equal(t, time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond), timefn.EndOfWeek(now, saturday))
+	assert.Equal(t, timefn.WeekMiddleEastern.StartOfWeek(now), timefn.StartOfWeek(now, saturday))
+	assert.Equal(t, timefn.WeekMiddleEastern.EndOfWeek(now), timefn.EndOfWeek(now, saturday))
+}
+
+func TestCalendar_BusinessCalendar(t *testing.T) {
+	friday := time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC)
+	sunday := time.Date(2023, time.April, 2, 12, 0, 0, 0, time.UTC)
+
+	c := timefn.NewCalendar(timefn.WeekendOn(time.Friday, time.Saturday))
+	assert.True(t, c.IsWeekend(friday))
+	assert.True(t, c.IsWeekday(sunday))
+
+	bc := c.BusinessCalendar(timefn.WithHolidays(sunday))
+	assert.False(t, bc.IsBusinessDay(friday))
+	assert.False(t, bc.IsBusinessDay(sunday))
+	assert.True(t, bc.IsBusinessDay(sunday.AddDate(0, 0, 1)))
+}
+
+func TestIsWeekend(t *testing.T) {
+	friday := time.Date(2023, time.March, 31, 12, 0, 0, 0, time.UTC)
+	saturday := friday.AddDate(0, 0, 1)
+	sunday := friday.AddDate(0, 0, 2)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		opts []timefn.CalendarOption
+		want bool
+	}{
+		{name: "friday", t: friday, want: false},
+		{name: "saturday", t: saturday, want: true},
+		{name: "sunday", t: sunday, want: true},
+		{name: "friday in friday-saturday weekend", t: friday, opts: []timefn.CalendarOption{timefn.WeekendOn(time.Friday, time.Saturday)}, want: true},
+		{name: "sunday in friday-saturday weekend", t: sunday, opts: []timefn.CalendarOption{timefn.WeekendOn(time.Friday, time.Saturday)}, want: false},
+		{name: "no weekend", t: saturday, opts: []timefn.CalendarOption{timefn.WeekendOn()}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, timefn.IsWeekend(tt.t, tt.opts...))
+			assert.Equal(t, !tt.want, timefn.IsWeekday(tt.t, tt.opts...))
+		})
+	}
+}
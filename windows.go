@@ -0,0 +1,85 @@
+package timefn
+
+import "time"
+
+// TumblingWindows returns consecutive, non-overlapping windows of the given
+// size that cover bounds, as used by stream processing frameworks. The windows
+// are aligned to the start of bounds, and all have the same size, so the last
+// window extends beyond the end of bounds if the duration of bounds is not a
+// multiple of size. TumblingWindows returns nil if size is not positive or
+// bounds is not a valid, closed period.
+func TumblingWindows(bounds Period, size time.Duration) []Period {
+	return HoppingWindows(bounds, size, size)
+}
+
+// HoppingWindows returns windows of the given size that start every hop,
+// beginning at the start of bounds, up to the end of bounds. Windows overlap
+// if hop is smaller than size and leave gaps if hop is larger than size; with
+// a hop equal to size, they are [TumblingWindows]. Like TumblingWindows, all
+// windows have the same size. HoppingWindows returns nil if size or hop is not
+// positive or bounds is not a valid, closed period. Use [HoppingWindowIter] to
+// avoid allocating all windows of large bounds at once.
+func HoppingWindows(bounds Period, size, hop time.Duration) []Period {
+	it := HoppingWindowIter(bounds, size, hop)
+
+	var out []Period
+	for w, ok := it.Next(); ok; w, ok = it.Next() {
+		out = append(out, w)
+	}
+	return out
+}
+
+// SlidingWindows returns one window per time in times, in the same order,
+// that covers the size leading up to the time. This is the window of a sliding
+// window aggregation that is evaluated at every event, such as "the number of
+// logins within the last 10 minutes of each login". Because periods do not
+// include their end, use [Period.ContainsInclusive] to include the event at
+// the end of the window itself. SlidingWindows returns nil if size is not
+// positive.
+func SlidingWindows(times []time.Time, size time.Duration) []Period {
+	if size <= 0 || len(times) == 0 {
+		return nil
+	}
+
+	out := make([]Period, len(times))
+	for i, t := range times {
+		out[i] = Period{Start: t.Add(-size), End: t}
+	}
+	return out
+}
+
+// WindowIterator yields the windows of [HoppingWindows] one by one. Use
+// [HoppingWindowIter] to create one.
+type WindowIterator struct {
+	next  time.Time
+	end   time.Time
+	size  time.Duration
+	hop   time.Duration
+	valid bool
+}
+
+// HoppingWindowIter returns a [WindowIterator] that yields the windows of
+// [HoppingWindows] in chronological order. For tumbling windows, pass size as
+// the hop.
+func HoppingWindowIter(bounds Period, size, hop time.Duration) *WindowIterator {
+	valid := size > 0 && hop > 0 && bounds.Validate() == nil
+	return &WindowIterator{
+		next:  bounds.Start,
+		end:   bounds.End,
+		size:  size,
+		hop:   hop,
+		valid: valid,
+	}
+}
+
+// Next returns the next window. It returns false when all windows have been
+// yielded or the windows are invalid.
+func (it *WindowIterator) Next() (Period, bool) {
+	if !it.valid || !it.next.Before(it.end) {
+		return Period{}, false
+	}
+
+	w := Period{Start: it.next, End: it.next.Add(it.size)}
+	it.next = it.next.Add(it.hop)
+	return w, true
+}
@@ -0,0 +1,118 @@
+package timefn_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+)
+
+func TestHoppingWindows(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	bounds := timefn.Period{Start: start, End: at(25)}
+
+	tests := []struct {
+		name string
+		got  []timefn.Period
+		want []timefn.Period
+	}{
+		{
+			name: "tumbling",
+			got:  timefn.TumblingWindows(bounds, 10*time.Minute),
+			want: []timefn.Period{
+				{Start: at(0), End: at(10)},
+				{Start: at(10), End: at(20)},
+				{Start: at(20), End: at(30)},
+			},
+		},
+		{
+			name: "tumbling exact",
+			got:  timefn.TumblingWindows(timefn.Period{Start: start, End: at(20)}, 10*time.Minute),
+			want: []timefn.Period{
+				{Start: at(0), End: at(10)},
+				{Start: at(10), End: at(20)},
+			},
+		},
+		{
+			name: "hopping overlapping",
+			got:  timefn.HoppingWindows(bounds, 10*time.Minute, 5*time.Minute),
+			want: []timefn.Period{
+				{Start: at(0), End: at(10)},
+				{Start: at(5), End: at(15)},
+				{Start: at(10), End: at(20)},
+				{Start: at(15), End: at(25)},
+				{Start: at(20), End: at(30)},
+			},
+		},
+		{
+			name: "hopping with gaps",
+			got:  timefn.HoppingWindows(bounds, 5*time.Minute, 10*time.Minute),
+			want: []timefn.Period{
+				{Start: at(0), End: at(5)},
+				{Start: at(10), End: at(15)},
+				{Start: at(20), End: at(25)},
+			},
+		},
+		{
+			name: "zero size",
+			got:  timefn.TumblingWindows(bounds, 0),
+		},
+		{
+			name: "negative hop",
+			got:  timefn.HoppingWindows(bounds, time.Minute, -time.Minute),
+		},
+		{
+			name: "open bounds",
+			got:  timefn.TumblingWindows(timefn.Period{Start: start}, time.Minute),
+		},
+		{
+			name: "inverted bounds",
+			got:  timefn.TumblingWindows(timefn.Period{Start: at(10), End: at(0)}, time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("windows = %v; want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHoppingWindowIter(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	bounds := timefn.Period{Start: start, End: start.AddDate(1, 0, 0)}
+
+	it := timefn.HoppingWindowIter(bounds, time.Hour, time.Minute)
+	for i := 0; i < 3; i++ {
+		w, ok := it.Next()
+		want := timefn.Period{Start: start.Add(time.Duration(i) * time.Minute), End: start.Add(time.Duration(i)*time.Minute + time.Hour)}
+		if !ok || w != want {
+			t.Fatalf("Next() = %v, %v; want %v, true", w, ok, want)
+		}
+	}
+
+	if _, ok := timefn.HoppingWindowIter(bounds, 0, time.Minute).Next(); ok {
+		t.Errorf("Next() of invalid windows returned a window")
+	}
+}
+
+func TestSlidingWindows(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{start.Add(10 * time.Minute), start}
+
+	want := []timefn.Period{
+		{Start: start, End: start.Add(10 * time.Minute)},
+		{Start: start.Add(-10 * time.Minute), End: start},
+	}
+	if got := timefn.SlidingWindows(times, 10*time.Minute); !reflect.DeepEqual(got, want) {
+		t.Errorf("SlidingWindows() = %v; want %v", got, want)
+	}
+
+	if got := timefn.SlidingWindows(times, 0); got != nil {
+		t.Errorf("SlidingWindows() with zero size = %v; want nil", got)
+	}
+}
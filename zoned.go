@@ -0,0 +1,83 @@
+package timefn
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// ZonedTime is an instant as seen in a specific location, as returned by
+// [InZones].
+type ZonedTime struct {
+	// Time is the instant in Location.
+	Time time.Time
+
+	// Location is the location in which the instant is seen.
+	Location *time.Location
+
+	// DayOffset is the number of calendar days by which the local date in
+	// Location differs from the date of the original time, e.g. 1 if it is
+	// already the next day in Location.
+	DayOffset int
+}
+
+// InZones returns the given instant as seen in each of the given locations, in
+// the order of the locations. The day offsets are computed relative to the
+// date of t in its own location.
+func InZones(t time.Time, zones ...*time.Location) []ZonedTime {
+	out := make([]ZonedTime, len(zones))
+	for i, loc := range zones {
+		local := t.In(loc)
+		out[i] = ZonedTime{
+			Time:      local,
+			Location:  loc,
+			DayOffset: DateOf(local).Sub(DateOf(t)),
+		}
+	}
+	return out
+}
+
+// String returns the zoned time in the form "2006-01-02 15:04 MST", followed by
+// the day offset in parentheses if it is non-zero, e.g. "(+1)". The zone name
+// is determined by [ZoneName].
+func (z ZonedTime) String() string {
+	s := FormatWithZoneName(z.Time, "2006-01-02 15:04")
+	if z.DayOffset != 0 {
+		s += fmt.Sprintf(" (%+d)", z.DayOffset)
+	}
+	return s
+}
+
+// FormatZoneTable formats the zoned times as an aligned plain-text table with
+// one row per zone, containing the name of the location, the local time
+// formatted with the given layout, the zone name and the day offset, if any:
+//
+//	America/New_York  Wed Mar 1 19:00  EST
+//	Europe/Berlin     Thu Mar 2 01:00  CET  +1
+//	Asia/Tokyo        Thu Mar 2 09:00  JST  +1
+//
+// If layout is empty, "Mon Jan 2 15:04" is used.
+func FormatZoneTable(zoned []ZonedTime, layout string) string {
+	if layout == "" {
+		layout = "Mon Jan 2 15:04"
+	}
+
+	var buf strings.Builder
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for _, z := range zoned {
+		offset := ""
+		if z.DayOffset != 0 {
+			offset = fmt.Sprintf("%+d", z.DayOffset)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", z.Location, z.Time.Format(layout), ZoneName(z.Time), offset)
+	}
+	w.Flush()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,58 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadLocations(t *testing.T, names ...string) []*time.Location {
+	t.Helper()
+
+	out := make([]*time.Location, len(names))
+	for i, name := range names {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatalf("load location %q: %v", name, err)
+		}
+		out[i] = loc
+	}
+	return out
+}
+
+func TestInZones(t *testing.T) {
+	zones := loadLocations(t, "America/New_York", "Europe/Berlin", "Asia/Tokyo")
+	now := time.Date(2023, time.March, 1, 19, 0, 0, 0, zones[0])
+
+	got := timefn.InZones(now, zones...)
+	if len(got) != 3 {
+		t.Fatalf("InZones() returned %d zoned times; want 3", len(got))
+	}
+
+	for i, z := range got {
+		assert.True(t, z.Time.Equal(now))
+		assert.Equal(t, zones[i], z.Location)
+		assert.Equal(t, zones[i], z.Time.Location())
+	}
+
+	assert.Equal(t, 0, got[0].DayOffset)
+	assert.Equal(t, 1, got[1].DayOffset)
+	assert.Equal(t, 1, got[2].DayOffset)
+
+	assert.Equal(t, "2023-03-01 19:00 EST", got[0].String())
+	assert.Equal(t, "2023-03-02 01:00 CET (+1)", got[1].String())
+}
+
+func TestFormatZoneTable(t *testing.T) {
+	zones := loadLocations(t, "America/New_York", "Europe/Berlin", "Asia/Tokyo")
+	now := time.Date(2023, time.March, 1, 19, 0, 0, 0, zones[0])
+
+	want := "America/New_York  Wed Mar 1 19:00  EST\n" +
+		"Europe/Berlin     Thu Mar 2 01:00  CET  +1\n" +
+		"Asia/Tokyo        Thu Mar 2 09:00  JST  +1"
+
+	assert.Equal(t, want, timefn.FormatZoneTable(timefn.InZones(now, zones...), ""))
+	assert.Equal(t, "UTC  00:00  UTC", timefn.FormatZoneTable(timefn.InZones(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), time.UTC), "15:04"))
+}
@@ -0,0 +1,74 @@
+package timefn
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormatWithZoneName formats t using the given layout, followed by a space and
+// the name of its zone as returned by [ZoneName]. The layout should not contain
+// zone elements itself.
+func FormatWithZoneName(t time.Time, layout string) string {
+	return t.Format(layout) + " " + ZoneName(t)
+}
+
+// ZoneName returns a consistent name for the zone of t: its abbreviation, such
+// as "PST" or "CEST", if the time zone database defines one, and its UTC
+// offset, such as "UTC+05:30", otherwise. Many zones have no abbreviation, in
+// which case Go reports numeric names like "+0530" or "-03" that do not look
+// like abbreviations and are easily confused with offsets in other formats.
+func ZoneName(t time.Time) string {
+	name, offset := t.Zone()
+	if isZoneAbbreviation(name) {
+		return name
+	}
+	return formatUTCOffset(offset)
+}
+
+func isZoneAbbreviation(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+// formatUTCOffset formats an offset in seconds east of UTC as "UTC+hh:mm".
+func formatUTCOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	return fmt.Sprintf("UTC%c%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+// FormatWithZones formats the period as "2006-01-02 15:04:05 -> 2006-01-02
+// 15:04:05" with zone names as returned by [ZoneName]. If both boundaries have
+// the same zone name, it is only printed once at the end; if they differ, for
+// example because the period spans a DST transition from PST to PDT, each
+// boundary is followed by its own zone name. Open boundaries are printed as
+// "-inf" and "+inf", so the zero Period, which has neither boundary, is
+// printed as "-inf -> +inf".
+func (p Period) FormatWithZones() string {
+	const layout = time.DateTime
+
+	switch {
+	case p.unbounded():
+		return "-inf -> +inf"
+	case p.OpenStart():
+		return "-inf -> " + FormatWithZoneName(p.End, layout)
+	case p.OpenEnd():
+		return FormatWithZoneName(p.Start, layout) + " -> +inf"
+	}
+
+	start, end := ZoneName(p.Start), ZoneName(p.End)
+	if start == end {
+		return p.Start.Format(layout) + " -> " + p.End.Format(layout) + " " + end
+	}
+
+	return FormatWithZoneName(p.Start, layout) + " -> " + FormatWithZoneName(p.End, layout)
+}
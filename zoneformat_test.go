@@ -0,0 +1,95 @@
+package timefn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneName(t *testing.T) {
+	tests := []struct {
+		zone string
+		t    time.Time
+		want string
+	}{
+		{zone: "UTC", t: time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC), want: "UTC"},
+		{zone: "America/Los_Angeles", t: time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC), want: "PST"},
+		{zone: "Europe/Berlin", t: time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC), want: "CEST"},
+		{zone: "America/Sao_Paulo", t: time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC), want: "UTC-03:00"},
+		{zone: "Asia/Kathmandu", t: time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC), want: "UTC+05:45"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatalf("load location: %v", err)
+			}
+			assert.Equal(t, tt.want, timefn.ZoneName(tt.t.In(loc)))
+		})
+	}
+
+	assert.Equal(t, "UTC+02:00", timefn.ZoneName(time.Date(2023, time.March, 1, 12, 0, 0, 0, time.FixedZone("", 2*3600))))
+}
+
+func TestFormatWithZoneName(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	got := timefn.FormatWithZoneName(time.Date(2023, time.March, 11, 10, 0, 0, 0, la), "Jan 2 15:04")
+	assert.Equal(t, "Mar 11 10:00 PST", got)
+}
+
+func TestPeriod_FormatWithZones(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		p    timefn.Period
+		want string
+	}{
+		{
+			name: "same zone",
+			p:    timefn.Period{Start: time.Date(2023, time.March, 1, 10, 0, 0, 0, la), End: time.Date(2023, time.March, 2, 10, 0, 0, 0, la)},
+			want: "2023-03-01 10:00:00 -> 2023-03-02 10:00:00 PST",
+		},
+		{
+			name: "across dst",
+			p:    timefn.Period{Start: time.Date(2023, time.March, 11, 10, 0, 0, 0, la), End: time.Date(2023, time.March, 12, 10, 0, 0, 0, la)},
+			want: "2023-03-11 10:00:00 PST -> 2023-03-12 10:00:00 PDT",
+		},
+		{
+			name: "open end",
+			p:    timefn.Period{Start: time.Date(2023, time.March, 11, 10, 0, 0, 0, la)},
+			want: "2023-03-11 10:00:00 PST -> +inf",
+		},
+		{
+			name: "open start",
+			p:    timefn.Period{End: time.Date(2023, time.March, 11, 10, 0, 0, 0, time.UTC)},
+			want: "-inf -> 2023-03-11 10:00:00 UTC",
+		},
+		{
+			name: "zero",
+			p:    timefn.Period{},
+			want: "-inf -> +inf",
+		},
+		{
+			name: "open at both ends",
+			p:    timefn.Merge([]timefn.Period{{End: time.Date(2023, time.March, 11, 10, 0, 0, 0, time.UTC)}, {Start: time.Date(2023, time.March, 11, 10, 0, 0, 0, time.UTC)}})[0],
+			want: "-inf -> +inf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.p.FormatWithZones())
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Package zones provides a catalog of IANA time zones for building time zone
+// pickers and for inferring the zone of imported data from its offsets.
+//
+// Importing the package embeds the time zone database (see [time/tzdata]), so
+// the catalog works on systems without zoneinfo files. This adds about 450 KB
+// to the binary.
+package zones
+
+import (
+	"sync"
+	"time"
+	_ "time/tzdata"
+)
+
+// common are widely used zones, roughly ordered from west to east.
+var common = [...]string{
+	"Pacific/Honolulu",
+	"America/Anchorage",
+	"America/Los_Angeles",
+	"America/Denver",
+	"America/Phoenix",
+	"America/Chicago",
+	"America/Mexico_City",
+	"America/New_York",
+	"America/Toronto",
+	"America/Bogota",
+	"America/Lima",
+	"America/Caracas",
+	"America/Halifax",
+	"America/Santiago",
+	"America/Sao_Paulo",
+	"America/Argentina/Buenos_Aires",
+	"Atlantic/Azores",
+	"UTC",
+	"Europe/London",
+	"Europe/Dublin",
+	"Europe/Lisbon",
+	"Africa/Lagos",
+	"Europe/Paris",
+	"Europe/Berlin",
+	"Europe/Madrid",
+	"Europe/Rome",
+	"Europe/Amsterdam",
+	"Europe/Zurich",
+	"Europe/Stockholm",
+	"Europe/Warsaw",
+	"Africa/Cairo",
+	"Africa/Johannesburg",
+	"Europe/Athens",
+	"Europe/Helsinki",
+	"Europe/Kyiv",
+	"Europe/Istanbul",
+	"Europe/Moscow",
+	"Africa/Nairobi",
+	"Asia/Riyadh",
+	"Asia/Tehran",
+	"Asia/Dubai",
+	"Asia/Karachi",
+	"Asia/Kolkata",
+	"Asia/Kathmandu",
+	"Asia/Dhaka",
+	"Asia/Bangkok",
+	"Asia/Jakarta",
+	"Asia/Singapore",
+	"Asia/Shanghai",
+	"Asia/Hong_Kong",
+	"Asia/Taipei",
+	"Australia/Perth",
+	"Asia/Seoul",
+	"Asia/Tokyo",
+	"Australia/Adelaide",
+	"Australia/Brisbane",
+	"Australia/Sydney",
+	"Pacific/Auckland",
+}
+
+// CommonZones returns the names of widely used time zones, such as
+// "Europe/Berlin" or "America/New_York", ordered roughly from west to east. It
+// is meant as a short default list for time zone pickers; use [Zones] for the
+// complete catalog.
+func CommonZones() []string {
+	return append([]string(nil), common[:]...)
+}
+
+// Zones returns the names of all canonical zones of the IANA time zone
+// database in alphabetical order. Links, i.e. alternative names such as
+// "Europe/Amsterdam", are not included.
+func Zones() []string {
+	return append([]string(nil), canonical[:]...)
+}
+
+var (
+	loadOnce  sync.Once
+	locations []*time.Location
+)
+
+// catalog returns the locations of all canonical zones.
+func catalog() []*time.Location {
+	loadOnce.Do(func() {
+		locations = make([]*time.Location, 0, len(canonical))
+		for _, name := range canonical {
+			if loc, err := time.LoadLocation(name); err == nil {
+				locations = append(locations, loc)
+			}
+		}
+	})
+	return locations
+}
+
+// ZonesWithOffsetAt returns the names of the canonical zones whose UTC offset
+// at t equals the given offset, in alphabetical order. Daylight saving time is
+// taken into account, so "Europe/Berlin" has an offset of 2 hours in July.
+func ZonesWithOffsetAt(t time.Time, offset time.Duration) []string {
+	return GuessZone([]OffsetSample{{At: t, Offset: offset}})
+}
+
+// OffsetSample is a UTC offset observed at a specific instant, such as the
+// offset of a timestamp in imported data.
+type OffsetSample struct {
+	At     time.Time
+	Offset time.Duration
+}
+
+// GuessZone returns the names of the canonical zones that are consistent with
+// all of the given offset samples, in alphabetical order. Samples from
+// different seasons narrow the result down, because they reveal whether and
+// when a zone observes daylight saving time. GuessZone returns nil if no zone
+// matches or if no samples are given.
+func GuessZone(offsetHistory []OffsetSample) []string {
+	if len(offsetHistory) == 0 {
+		return nil
+	}
+
+	var out []string
+	for _, loc := range catalog() {
+		if matchesOffsets(loc, offsetHistory) {
+			out = append(out, loc.String())
+		}
+	}
+
+	return out
+}
+
+func matchesOffsets(loc *time.Location, samples []OffsetSample) bool {
+	for _, s := range samples {
+		if _, offset := s.At.In(loc).Zone(); time.Duration(offset)*time.Second != s.Offset {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package zones_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bounoable/timefn/zones"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommonZones(t *testing.T) {
+	for _, name := range zones.CommonZones() {
+		if _, err := time.LoadLocation(name); err != nil {
+			t.Errorf("load common zone %q: %v", name, err)
+		}
+	}
+}
+
+func TestZones(t *testing.T) {
+	all := zones.Zones()
+	assert.Contains(t, all, "Europe/Berlin")
+	assert.NotContains(t, all, "Europe/Amsterdam")
+
+	for _, name := range all {
+		if _, err := time.LoadLocation(name); err != nil {
+			t.Errorf("load zone %q: %v", name, err)
+		}
+	}
+}
+
+func TestZonesWithOffsetAt(t *testing.T) {
+	summer := time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC)
+	winter := time.Date(2023, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Contains(t, zones.ZonesWithOffsetAt(summer, 2*time.Hour), "Europe/Berlin")
+	assert.NotContains(t, zones.ZonesWithOffsetAt(winter, 2*time.Hour), "Europe/Berlin")
+	assert.Contains(t, zones.ZonesWithOffsetAt(winter, 5*time.Hour+30*time.Minute), "Asia/Kolkata")
+	assert.Empty(t, zones.ZonesWithOffsetAt(winter, 17*time.Minute))
+}
+
+func TestGuessZone(t *testing.T) {
+	history := []zones.OffsetSample{
+		{At: time.Date(2023, time.January, 15, 12, 0, 0, 0, time.UTC), Offset: -5 * time.Hour},
+		{At: time.Date(2023, time.July, 15, 12, 0, 0, 0, time.UTC), Offset: -4 * time.Hour},
+	}
+
+	got := zones.GuessZone(history)
+	assert.Contains(t, got, "America/New_York")
+	assert.Contains(t, got, "America/Toronto")
+	assert.NotContains(t, got, "America/Bogota")
+	assert.NotContains(t, got, "America/Chicago")
+
+	// Bogota stays at UTC-5 all year.
+	history[1].Offset = -5 * time.Hour
+	got = zones.GuessZone(history)
+	assert.Contains(t, got, "America/Bogota")
+	assert.NotContains(t, got, "America/New_York")
+
+	assert.Nil(t, zones.GuessZone(nil))
+}